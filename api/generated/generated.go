@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package generated
 
 import (
@@ -23,11 +23,60 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	AdminApiKeyScopes    = "adminApiKey.Scopes"
+	AdminSignatureScopes = "adminSignature.Scopes"
+)
+
+// Defines values for BotClaimMappingSource.
+const (
+	FirstName    BotClaimMappingSource = "first_name"
+	FullName     BotClaimMappingSource = "full_name"
+	IsPremium    BotClaimMappingSource = "is_premium"
+	LanguageCode BotClaimMappingSource = "language_code"
+	LastName     BotClaimMappingSource = "last_name"
+	PhotoUrl     BotClaimMappingSource = "photo_url"
+	TelegramId   BotClaimMappingSource = "telegram_id"
+	Username     BotClaimMappingSource = "username"
+)
+
+// Defines values for BotCredentialsStatus.
+const (
+	CredentialsInvalid BotCredentialsStatus = "credentials_invalid"
+	Valid              BotCredentialsStatus = "valid"
+)
+
+// Defines values for BotStatus.
+const (
+	Active      BotStatus = "active"
+	Disabled    BotStatus = "disabled"
+	Maintenance BotStatus = "maintenance"
+)
+
 // Defines values for ConflictDetailsType.
 const (
 	Conflict ConflictDetailsType = "conflict"
 )
 
+// Defines values for LoginEventMethod.
+const (
+	Bot       LoginEventMethod = "bot"
+	Challenge LoginEventMethod = "challenge"
+	LoginHint LoginEventMethod = "login_hint"
+	LoginUrl  LoginEventMethod = "login_url"
+	MiniApp   LoginEventMethod = "mini_app"
+	Skip      LoginEventMethod = "skip"
+	Widget    LoginEventMethod = "widget"
+)
+
+// Defines values for LoginEventOutcome.
+const (
+	LoginEventOutcomeFailed   LoginEventOutcome = "failed"
+	LoginEventOutcomeIssued   LoginEventOutcome = "issued"
+	LoginEventOutcomeRejected LoginEventOutcome = "rejected"
+	LoginEventOutcomeSuccess  LoginEventOutcome = "success"
+)
+
 // Defines values for ObjectInvalidDetailsType.
 const (
 	ObjectInvalid ObjectInvalidDetailsType = "object_invalid"
@@ -38,6 +87,234 @@ const (
 	ObjectNotFound ObjectNotFoundDetailsType = "object_not_found"
 )
 
+// Defines values for TelegramWebhookReplyMethod.
+const (
+	EditMessageText TelegramWebhookReplyMethod = "editMessageText"
+	SendMessage     TelegramWebhookReplyMethod = "sendMessage"
+)
+
+// Defines values for PostBotsJSONBodyInitDataVerification.
+const (
+	Hash      PostBotsJSONBodyInitDataVerification = "hash"
+	Signature PostBotsJSONBodyInitDataVerification = "signature"
+)
+
+// Defines values for PostBotsIdClientsJSONBodyGrantTypes.
+const (
+	AuthorizationCode PostBotsIdClientsJSONBodyGrantTypes = "authorization_code"
+	ClientCredentials PostBotsIdClientsJSONBodyGrantTypes = "client_credentials"
+	Implicit          PostBotsIdClientsJSONBodyGrantTypes = "implicit"
+	RefreshToken      PostBotsIdClientsJSONBodyGrantTypes = "refresh_token"
+)
+
+// Defines values for PostBotsIdClientsJSONBodyTokenEndpointAuthMethod.
+const (
+	ClientSecretBasic PostBotsIdClientsJSONBodyTokenEndpointAuthMethod = "client_secret_basic"
+	ClientSecretPost  PostBotsIdClientsJSONBodyTokenEndpointAuthMethod = "client_secret_post"
+	None              PostBotsIdClientsJSONBodyTokenEndpointAuthMethod = "none"
+	PrivateKeyJwt     PostBotsIdClientsJSONBodyTokenEndpointAuthMethod = "private_key_jwt"
+)
+
+// Defines values for GetBotsIdUsersParamsOrder.
+const (
+	LastLoginAsc  GetBotsIdUsersParamsOrder = "last_login_asc"
+	LastLoginDesc GetBotsIdUsersParamsOrder = "last_login_desc"
+)
+
+// Defines values for GetLoginEventsParamsOutcome.
+const (
+	GetLoginEventsParamsOutcomeFailed   GetLoginEventsParamsOutcome = "failed"
+	GetLoginEventsParamsOutcomeIssued   GetLoginEventsParamsOutcome = "issued"
+	GetLoginEventsParamsOutcomeRejected GetLoginEventsParamsOutcome = "rejected"
+	GetLoginEventsParamsOutcomeSuccess  GetLoginEventsParamsOutcome = "success"
+)
+
+// BotAccessPolicy Rules a Telegram user must satisfy to sign in through the bot. Denied user ids are checked first; empty lists impose no restriction. Chat memberships are required through the bot chats. The former `required_chat_id` was moved into the bot chats with the group name `chat<chat_id>`: a user who is a member of any other bot chat may now sign in without being a member of it, and granted users get the `chat<chat_id>` group in the groups claim. Rename the group with the bot chats endpoint if needed.
+type BotAccessPolicy struct {
+	// AllowedLanguages IETF language codes of the Telegram client allowed to sign in. A primary language (e.g., "en") also matches its regional variants.
+	AllowedLanguages *[]string `json:"allowed_languages,omitempty"`
+
+	// AllowedUserIds Telegram user ids allowed to sign in. Empty allows everyone.
+	AllowedUserIds *[]int64 `json:"allowed_user_ids,omitempty"`
+
+	// DeniedUserIds Telegram user ids never allowed to sign in
+	DeniedUserIds *[]int64 `json:"denied_user_ids,omitempty"`
+
+	// RequirePremium Only Telegram Premium users may sign in
+	RequirePremium *bool `json:"require_premium,omitempty"`
+
+	// RequireUsername Only users with a public username may sign in
+	RequireUsername *bool `json:"require_username,omitempty"`
+}
+
+// BotAccessPolicyResponse defines model for BotAccessPolicyResponse.
+type BotAccessPolicyResponse struct {
+	// AllowedLanguages IETF language codes of the Telegram client allowed to sign in. A primary language (e.g., "en") also matches its regional variants.
+	AllowedLanguages []string `json:"allowed_languages"`
+
+	// AllowedUserIds Telegram user ids allowed to sign in. Empty allows everyone.
+	AllowedUserIds []int64   `json:"allowed_user_ids"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	// DeniedUserIds Telegram user ids never allowed to sign in
+	DeniedUserIds []int64 `json:"denied_user_ids"`
+
+	// RequirePremium Only Telegram Premium users may sign in
+	RequirePremium bool `json:"require_premium"`
+
+	// RequireUsername Only users with a public username may sign in
+	RequireUsername bool       `json:"require_username"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+// BotBriefResponse defines model for BotBriefResponse.
+type BotBriefResponse struct {
+	// ClientId OIDC client ID associated with the bot
+	ClientId *string `json:"client_id,omitempty"`
+
+	// CredentialsStatus Whether Telegram accepted the bot token on the last check. Logins to bots with `credentials_invalid` are rejected until the bot is synced with a new token.
+	CredentialsStatus BotCredentialsStatus `json:"credentials_status"`
+	Id                int64                `json:"id"`
+	Name              string               `json:"name"`
+
+	// Status Lifecycle status of the bot. Logins to `disabled` bots are rejected as denied, logins to bots under `maintenance` are rejected as temporarily unavailable.
+	Status   BotStatus `json:"status"`
+	Username string    `json:"username"`
+}
+
+// BotChat Telegram group or channel bound to the bot. When the bot has chats, only users who are members, administrators or creators of at least one of them may sign in; membership of every chat is not required. The bot must be able to see chat members, usually as a chat administrator.
+type BotChat struct {
+	ChatId int64 `json:"chat_id"`
+
+	// Group Group name emitted in the groups claim when the groups scope is granted. Administrators and creators also get "<group>:administrator" or "<group>:creator".
+	Group string `json:"group"`
+}
+
+// BotChatList defines model for BotChatList.
+type BotChatList struct {
+	Items []BotChat `json:"items"`
+}
+
+// BotClaimMapping Custom id_token claim emitted for the bot's clients when the scope is granted. Mapped claims override standard claims with the same name. Registered JWT claims and the groups claim are reserved.
+type BotClaimMapping struct {
+	Claim string `json:"claim"`
+	Scope string `json:"scope"`
+
+	// Source Bot user field the claim value is taken from
+	Source BotClaimMappingSource `json:"source"`
+}
+
+// BotClaimMappingSource Bot user field the claim value is taken from
+type BotClaimMappingSource string
+
+// BotClaimMappingList defines model for BotClaimMappingList.
+type BotClaimMappingList struct {
+	Items []BotClaimMapping `json:"items"`
+}
+
+// BotCredentialsStatus Whether Telegram accepted the bot token on the last check. Logins to bots with `credentials_invalid` are rejected until the bot is synced with a new token.
+type BotCredentialsStatus string
+
+// BotListResponse defines model for BotListResponse.
+type BotListResponse struct {
+	Items []BotBriefResponse `json:"items"`
+
+	// NextCursor Cursor of the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// BotResponse defines model for BotResponse.
+type BotResponse struct {
+	// CanJoinGroups Whether the bot can be added to groups
+	CanJoinGroups bool `json:"can_join_groups"`
+
+	// ClientId OIDC client ID associated with the bot
+	ClientId *string `json:"client_id,omitempty"`
+
+	// CredentialsCheckedAt Time of the last token check, absent when the token was never checked
+	CredentialsCheckedAt *time.Time `json:"credentials_checked_at,omitempty"`
+
+	// CredentialsStatus Whether Telegram accepted the bot token on the last check. Logins to bots with `credentials_invalid` are rejected until the bot is synced with a new token.
+	CredentialsStatus BotCredentialsStatus `json:"credentials_status"`
+
+	// Description Bot description from Telegram, empty when not set
+	Description string  `json:"description"`
+	Id          int64   `json:"id"`
+	Name        string  `json:"name"`
+	PhotoUrl    *string `json:"photo_url"`
+
+	// ShortDescription Bot short description from Telegram, empty when not set
+	ShortDescription string `json:"short_description"`
+
+	// Status Lifecycle status of the bot. Logins to `disabled` bots are rejected as denied, logins to bots under `maintenance` are rejected as temporarily unavailable.
+	Status          BotStatus  `json:"status"`
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	StatusReason    *string    `json:"status_reason,omitempty"`
+
+	// SupportsInlineQueries Whether the bot supports inline queries
+	SupportsInlineQueries bool   `json:"supports_inline_queries"`
+	Url                   string `json:"url"`
+	Username              string `json:"username"`
+}
+
+// BotStatus Lifecycle status of the bot. Logins to `disabled` bots are rejected as denied, logins to bots under `maintenance` are rejected as temporarily unavailable.
+type BotStatus string
+
+// BotStatusRequest defines model for BotStatusRequest.
+type BotStatusRequest struct {
+	// Reason Reason of the change, shown to users whose logins are rejected
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Lifecycle status of the bot. Logins to `disabled` bots are rejected as denied, logins to bots under `maintenance` are rejected as temporarily unavailable.
+	Status BotStatus `json:"status"`
+}
+
+// BotStatusResponse defines model for BotStatusResponse.
+type BotStatusResponse struct {
+	// ChangedAt Time of the last status change, absent when the status was never changed
+	ChangedAt *time.Time `json:"changed_at,omitempty"`
+	Id        int64      `json:"id"`
+	Reason    *string    `json:"reason,omitempty"`
+
+	// Status Lifecycle status of the bot. Logins to `disabled` bots are rejected as denied, logins to bots under `maintenance` are rejected as temporarily unavailable.
+	Status BotStatus `json:"status"`
+
+	// Updated Indicates whether the status was changed (true) or already set (false)
+	Updated bool `json:"updated"`
+}
+
+// BotUserListResponse defines model for BotUserListResponse.
+type BotUserListResponse struct {
+	Items []BotUserResponse `json:"items"`
+
+	// NextCursor Cursor of the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// BotUserResponse defines model for BotUserResponse.
+type BotUserResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	FirstName string    `json:"first_name"`
+
+	// Ip IP address of the last login
+	Ip        string `json:"ip"`
+	IsPremium *bool  `json:"is_premium,omitempty"`
+
+	// Language IETF language tag reported by Telegram
+	Language    *string    `json:"language,omitempty"`
+	LastLoginAt time.Time  `json:"last_login_at"`
+	LastName    *string    `json:"last_name,omitempty"`
+	PhotoUrl    *string    `json:"photo_url,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// UserAgent User agent of the last login
+	UserAgent *string `json:"user_agent,omitempty"`
+
+	// UserId Telegram user ID
+	UserId   int64   `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+}
+
 // ConflictDetails defines model for ConflictDetails.
 type ConflictDetails struct {
 	// Feature The feature that caused the conflict
@@ -53,6 +330,21 @@ type ConflictDetails struct {
 // ConflictDetailsType Error detail type discriminator
 type ConflictDetailsType string
 
+// ConsentGrant defines model for ConsentGrant.
+type ConsentGrant struct {
+	ClientId        string     `json:"client_id"`
+	ClientName      *string    `json:"client_name,omitempty"`
+	GrantedAudience []string   `json:"granted_audience"`
+	GrantedScopes   []string   `json:"granted_scopes"`
+	HandledAt       *time.Time `json:"handled_at,omitempty"`
+
+	// Remember Whether the consent is remembered for subsequent requests of the client
+	Remember bool `json:"remember"`
+
+	// RememberFor Seconds the consent is remembered for, 0 means forever
+	RememberFor *int64 `json:"remember_for,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Details Additional details about the error
@@ -67,6 +359,42 @@ type ErrorResponse_Details struct {
 	union json.RawMessage
 }
 
+// LoginEvent defines model for LoginEvent.
+type LoginEvent struct {
+	BotId     *int64    `json:"bot_id,omitempty"`
+	ClientId  *string   `json:"client_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// ErrorCode OAuth2 error the attempt failed with
+	ErrorCode *string `json:"error_code,omitempty"`
+	Id        int64   `json:"id"`
+	Ip        *string `json:"ip,omitempty"`
+
+	// Method How the login was attempted. `bot` is a login confirmed in the bot through a deep link, `login_url` is a Telegram login URL button issuing a login hint, `skip` is a login skipped by ORY Hydra for an authenticated session, `login_hint` is a login with a hint issued by a login URL, and `challenge` is a login challenge rejected before a login method was chosen. Unknown login challenges are not recorded.
+	Method LoginEventMethod `json:"method"`
+
+	// Outcome `issued` means a login URL issued a login hint, the login is recorded again when the hint is used. `rejected` means the login request was rejected in ORY Hydra, `failed` means the attempt failed and the user was shown the login page instead.
+	Outcome   LoginEventOutcome `json:"outcome"`
+	UserAgent *string           `json:"user_agent,omitempty"`
+
+	// UserId Telegram user ID, absent when the user is not known yet
+	UserId *int64 `json:"user_id,omitempty"`
+}
+
+// LoginEventMethod How the login was attempted. `bot` is a login confirmed in the bot through a deep link, `login_url` is a Telegram login URL button issuing a login hint, `skip` is a login skipped by ORY Hydra for an authenticated session, `login_hint` is a login with a hint issued by a login URL, and `challenge` is a login challenge rejected before a login method was chosen. Unknown login challenges are not recorded.
+type LoginEventMethod string
+
+// LoginEventOutcome `issued` means a login URL issued a login hint, the login is recorded again when the hint is used. `rejected` means the login request was rejected in ORY Hydra, `failed` means the attempt failed and the user was shown the login page instead.
+type LoginEventOutcome string
+
+// LoginEventListResponse defines model for LoginEventListResponse.
+type LoginEventListResponse struct {
+	Items []LoginEvent `json:"items"`
+
+	// NextCursor Cursor of the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
 // ObjectInvalidDetails defines model for ObjectInvalidDetails.
 type ObjectInvalidDetails struct {
 	// Field The field that contains invalid data
@@ -100,31 +428,242 @@ type ObjectNotFoundDetails struct {
 // ObjectNotFoundDetailsType Error detail type discriminator
 type ObjectNotFoundDetailsType string
 
+// TelegramCallbackQuery defines model for TelegramCallbackQuery.
+type TelegramCallbackQuery struct {
+	Data    *string          `json:"data,omitempty"`
+	From    TelegramUser     `json:"from"`
+	Id      string           `json:"id"`
+	Message *TelegramMessage `json:"message,omitempty"`
+}
+
+// TelegramInlineKeyboardButton defines model for TelegramInlineKeyboardButton.
+type TelegramInlineKeyboardButton struct {
+	CallbackData string `json:"callback_data"`
+	Text         string `json:"text"`
+}
+
+// TelegramInlineKeyboardMarkup defines model for TelegramInlineKeyboardMarkup.
+type TelegramInlineKeyboardMarkup struct {
+	InlineKeyboard [][]TelegramInlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// TelegramMessage defines model for TelegramMessage.
+type TelegramMessage struct {
+	Chat struct {
+		Id int64 `json:"id"`
+	} `json:"chat"`
+	From      *TelegramUser `json:"from,omitempty"`
+	MessageId int64         `json:"message_id"`
+	Text      *string       `json:"text,omitempty"`
+}
+
+// TelegramUpdate Subset of the Telegram Update object used by the provider.
+type TelegramUpdate struct {
+	CallbackQuery *TelegramCallbackQuery `json:"callback_query,omitempty"`
+	Message       *TelegramMessage       `json:"message,omitempty"`
+	UpdateId      int64                  `json:"update_id"`
+}
+
+// TelegramUser defines model for TelegramUser.
+type TelegramUser struct {
+	FirstName    string  `json:"first_name"`
+	Id           int64   `json:"id"`
+	IsPremium    *bool   `json:"is_premium,omitempty"`
+	LanguageCode *string `json:"language_code,omitempty"`
+	LastName     *string `json:"last_name,omitempty"`
+	Username     *string `json:"username,omitempty"`
+}
+
+// TelegramWebhookReply Bot API method executed by Telegram on behalf of the bot. Empty when the update does not require a reply.
+type TelegramWebhookReply struct {
+	ChatId *int64 `json:"chat_id,omitempty"`
+
+	// MessageId Message to edit, set for editMessageText.
+	MessageId   *int64                        `json:"message_id,omitempty"`
+	Method      *TelegramWebhookReplyMethod   `json:"method,omitempty"`
+	ReplyMarkup *TelegramInlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	Text        *string                       `json:"text,omitempty"`
+}
+
+// TelegramWebhookReplyMethod defines model for TelegramWebhookReply.Method.
+type TelegramWebhookReplyMethod string
+
+// UserDataErasure defines model for UserDataErasure.
+type UserDataErasure struct {
+	// DeletedBotUsers Number of deleted bot user profiles
+	DeletedBotUsers int64 `json:"deleted_bot_users"`
+
+	// DeletedLoginEvents Number of deleted login events
+	DeletedLoginEvents int64 `json:"deleted_login_events"`
+}
+
+// UserDataExport Everything stored about a Telegram user across all bots
+type UserDataExport struct {
+	// BotUsers Profiles stored per bot, including the IP address and user agent of the last login
+	BotUsers []UserDataExportBotUser `json:"bot_users"`
+
+	// ConsentGrants Consents given to OIDC clients in ORY Hydra
+	ConsentGrants []ConsentGrant `json:"consent_grants"`
+
+	// LoginEvents Login attempts of the user ordered by time
+	LoginEvents []LoginEvent `json:"login_events"`
+	UserId      int64        `json:"user_id"`
+}
+
+// UserDataExportBotUser defines model for UserDataExportBotUser.
+type UserDataExportBotUser struct {
+	BotId     int64     `json:"bot_id"`
+	CreatedAt time.Time `json:"created_at"`
+	FirstName string    `json:"first_name"`
+
+	// Ip IP address of the last login
+	Ip        string `json:"ip"`
+	IsPremium *bool  `json:"is_premium,omitempty"`
+
+	// Language IETF language tag reported by Telegram
+	Language    *string    `json:"language,omitempty"`
+	LastLoginAt time.Time  `json:"last_login_at"`
+	LastName    *string    `json:"last_name,omitempty"`
+	PhotoUrl    *string    `json:"photo_url,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// UserAgent User agent of the last login
+	UserAgent *string `json:"user_agent,omitempty"`
+
+	// UserId Telegram user ID
+	UserId   int64   `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+}
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// GetBotCallbackParams defines parameters for GetBotCallback.
+type GetBotCallbackParams struct {
+	// LoginChallenge Unique login request identifier issued by ORY Hydra.
+	LoginChallenge string `form:"login_challenge" json:"login_challenge"`
+
+	// Nonce One-time nonce embedded into the bot deep link.
+	Nonce string `form:"nonce" json:"nonce"`
+
+	// UserAgent User agent of the client
+	UserAgent *string `json:"User-Agent,omitempty"`
+
+	// AcceptLanguage Optional language preference (e.g., en, ru, fr)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// GetBotsParams defines parameters for GetBots.
+type GetBotsParams struct {
+	// Linked Return only bots linked (true) or not linked (false) to an OIDC client
+	Linked *bool `form:"linked,omitempty" json:"linked,omitempty"`
+
+	// UsernamePrefix Return only bots whose username starts with the prefix (case-insensitive)
+	UsernamePrefix *string `form:"username_prefix,omitempty" json:"username_prefix,omitempty"`
+
+	// Cursor Cursor returned as `next_cursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // PostBotsJSONBody defines parameters for PostBots.
 type PostBotsJSONBody struct {
+	// InitDataVerification How Mini App init data of this bot is verified. `hash` uses the HMAC-SHA256 hash derived from the bot token, `signature` uses Telegram's Ed25519 third-party signature and does not need the token. Left unchanged when omitted.
+	InitDataVerification *PostBotsJSONBodyInitDataVerification `json:"init_data_verification,omitempty"`
+
 	// Token Telegram bot token
 	Token string `json:"token"`
 }
 
+// PostBotsJSONBodyInitDataVerification defines parameters for PostBots.
+type PostBotsJSONBodyInitDataVerification string
+
+// PutBotsIdClientJSONBody defines parameters for PutBotsIdClient.
+type PutBotsIdClientJSONBody struct {
+	// ClientId OAuth2 client ID registered in ORY Hydra
+	ClientId string `json:"client_id"`
+}
+
+// PostBotsIdClientsJSONBody defines parameters for PostBotsIdClients.
+type PostBotsIdClientsJSONBody struct {
+	// ClientName Human-readable client name. Defaults to the bot name.
+	ClientName *string `json:"client_name,omitempty"`
+
+	// GrantTypes Defaults to `authorization_code` and `refresh_token`.
+	GrantTypes   *[]PostBotsIdClientsJSONBodyGrantTypes `json:"grant_types,omitempty"`
+	RedirectUris []string                               `json:"redirect_uris"`
+
+	// Scopes Defaults to `openid` and `profile`.
+	Scopes *[]string `json:"scopes,omitempty"`
+
+	// TokenEndpointAuthMethod Defaults to `client_secret_basic`. Use `none` for public clients.
+	TokenEndpointAuthMethod *PostBotsIdClientsJSONBodyTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// PostBotsIdClientsJSONBodyGrantTypes defines parameters for PostBotsIdClients.
+type PostBotsIdClientsJSONBodyGrantTypes string
+
+// PostBotsIdClientsJSONBodyTokenEndpointAuthMethod defines parameters for PostBotsIdClients.
+type PostBotsIdClientsJSONBodyTokenEndpointAuthMethod string
+
+// GetBotsIdUsersParams defines parameters for GetBotsIdUsers.
+type GetBotsIdUsersParams struct {
+	// Search Return only users whose username, first name or last name contains the value (case-insensitive). A leading `@` is ignored.
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Order Order of users by the last login
+	Order *GetBotsIdUsersParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Cursor returned as `next_cursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetBotsIdUsersParamsOrder defines parameters for GetBotsIdUsers.
+type GetBotsIdUsersParamsOrder string
+
+// GetLoginEventsParams defines parameters for GetLoginEvents.
+type GetLoginEventsParams struct {
+	BotId *int64 `form:"bot_id,omitempty" json:"bot_id,omitempty"`
+
+	// UserId Telegram user ID
+	UserId   *int64                       `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ClientId *string                      `form:"client_id,omitempty" json:"client_id,omitempty"`
+	Outcome  *GetLoginEventsParamsOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// Since Return only events created at or after the time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Return only events created before the time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Cursor Cursor returned as `next_cursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetLoginEventsParamsOutcome defines parameters for GetLoginEvents.
+type GetLoginEventsParamsOutcome string
+
 // GetMiniappCallbackParams defines parameters for GetMiniappCallback.
 type GetMiniappCallbackParams struct {
 	// LoginChallenge Unique login request identifier issued by ORY Hydra.
 	LoginChallenge string `form:"login_challenge" json:"login_challenge"`
 
-	// TelegramMiniAppAuthData Collection of parameters returned by the Telegram Mini App. Passed as form-style query parameters (explode=true), for example:
-	//   - id
-	//   - first_name
-	//   - last_name
-	//   - username
-	//   - photo_url
+	// InitData Raw `Telegram.WebApp.initData` string as provided by the Telegram Mini App, URL-encoded as a single query parameter. It contains, for example:
+	//   - query_id
+	//   - user (JSON-serialized WebAppUser)
 	//   - auth_date
 	//   - hash
 	//
 	// The server must:
-	//   1. Verify the signature (hash) according to Telegram’s algorithm.
+	//   1. Verify the signature (hash) using the "WebAppData" key derivation.
 	//   2. Validate auth_date freshness.
 	//   3. Accept or reject the login request via ORY Hydra.
-	TelegramMiniAppAuthData map[string]interface{} `form:"telegram_mini_app_auth_data" json:"telegram_mini_app_auth_data"`
+	InitData string `form:"init_data" json:"init_data"`
 
 	// UserAgent User agent of the client
 	UserAgent *string `json:"User-Agent,omitempty"`
@@ -133,6 +672,12 @@ type GetMiniappCallbackParams struct {
 	AcceptLanguage *string `json:"Accept-Language,omitempty"`
 }
 
+// PostTelegramWebhookBotIdParams defines parameters for PostTelegramWebhookBotId.
+type PostTelegramWebhookBotIdParams struct {
+	// XTelegramBotApiSecretToken Per-bot secret token set when the webhook was registered.
+	XTelegramBotApiSecretToken string `json:"X-Telegram-Bot-Api-Secret-Token"`
+}
+
 // GetWidgetCallbackParams defines parameters for GetWidgetCallback.
 type GetWidgetCallbackParams struct {
 	// LoginChallenge Unique login request identifier issued by ORY Hydra.
@@ -163,6 +708,27 @@ type GetWidgetCallbackParams struct {
 // PostBotsJSONRequestBody defines body for PostBots for application/json ContentType.
 type PostBotsJSONRequestBody PostBotsJSONBody
 
+// PutBotsIdAccessPolicyJSONRequestBody defines body for PutBotsIdAccessPolicy for application/json ContentType.
+type PutBotsIdAccessPolicyJSONRequestBody = BotAccessPolicy
+
+// PutBotsIdChatsJSONRequestBody defines body for PutBotsIdChats for application/json ContentType.
+type PutBotsIdChatsJSONRequestBody = BotChatList
+
+// PutBotsIdClaimMappingsJSONRequestBody defines body for PutBotsIdClaimMappings for application/json ContentType.
+type PutBotsIdClaimMappingsJSONRequestBody = BotClaimMappingList
+
+// PutBotsIdClientJSONRequestBody defines body for PutBotsIdClient for application/json ContentType.
+type PutBotsIdClientJSONRequestBody PutBotsIdClientJSONBody
+
+// PostBotsIdClientsJSONRequestBody defines body for PostBotsIdClients for application/json ContentType.
+type PostBotsIdClientsJSONRequestBody PostBotsIdClientsJSONBody
+
+// PutBotsIdStatusJSONRequestBody defines body for PutBotsIdStatus for application/json ContentType.
+type PutBotsIdStatusJSONRequestBody = BotStatusRequest
+
+// PostTelegramWebhookBotIdJSONRequestBody defines body for PostTelegramWebhookBotId for application/json ContentType.
+type PostTelegramWebhookBotIdJSONRequestBody = TelegramUpdate
+
 // AsObjectNotFoundDetails returns the union data inside the ErrorResponse_Details as a ObjectNotFoundDetails
 func (t ErrorResponse_Details) AsObjectNotFoundDetails() (ObjectNotFoundDetails, error) {
 	var body ObjectNotFoundDetails
@@ -284,12 +850,78 @@ func (t *ErrorResponse_Details) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Complete login confirmed in the Telegram bot
+	// (GET /bot/callback)
+	GetBotCallback(ctx echo.Context, params GetBotCallbackParams) error
+	// List registered bots
+	// (GET /bots)
+	GetBots(ctx echo.Context, params GetBotsParams) error
 	// Sync Telegram bot by token
 	// (POST /bots)
 	PostBots(ctx echo.Context) error
+	// Delete bot
+	// (DELETE /bots/{id})
+	DeleteBotsId(ctx echo.Context, id int64) error
+	// Get bot
+	// (GET /bots/{id})
+	GetBotsId(ctx echo.Context, id int64) error
+	// Delete access policy of the bot
+	// (DELETE /bots/{id}/access-policy)
+	DeleteBotsIdAccessPolicy(ctx echo.Context, id int64) error
+	// Get access policy of the bot
+	// (GET /bots/{id}/access-policy)
+	GetBotsIdAccessPolicy(ctx echo.Context, id int64) error
+	// Set access policy of the bot
+	// (PUT /bots/{id}/access-policy)
+	PutBotsIdAccessPolicy(ctx echo.Context, id int64) error
+	// List Telegram chats of the bot
+	// (GET /bots/{id}/chats)
+	GetBotsIdChats(ctx echo.Context, id int64) error
+	// Replace Telegram chats of the bot
+	// (PUT /bots/{id}/chats)
+	PutBotsIdChats(ctx echo.Context, id int64) error
+	// List scope to claim mappings of the bot
+	// (GET /bots/{id}/claim-mappings)
+	GetBotsIdClaimMappings(ctx echo.Context, id int64) error
+	// Replace scope to claim mappings of the bot
+	// (PUT /bots/{id}/claim-mappings)
+	PutBotsIdClaimMappings(ctx echo.Context, id int64) error
+	// Unlink bot from OIDC client
+	// (DELETE /bots/{id}/client)
+	DeleteBotsIdClient(ctx echo.Context, id int64) error
+	// Link bot to OIDC client
+	// (PUT /bots/{id}/client)
+	PutBotsIdClient(ctx echo.Context, id int64) error
+	// Create OIDC client for bot
+	// (POST /bots/{id}/clients)
+	PostBotsIdClients(ctx echo.Context, id int64) error
+	// Set lifecycle status of the bot
+	// (PUT /bots/{id}/status)
+	PutBotsIdStatus(ctx echo.Context, id int64) error
+	// List users signed in through the bot
+	// (GET /bots/{id}/users)
+	GetBotsIdUsers(ctx echo.Context, id int64, params GetBotsIdUsersParams) error
+	// Delete bot user
+	// (DELETE /bots/{id}/users/{user_id})
+	DeleteBotsIdUsersUserId(ctx echo.Context, id int64, userId int64) error
+	// Get bot user
+	// (GET /bots/{id}/users/{user_id})
+	GetBotsIdUsersUserId(ctx echo.Context, id int64, userId int64) error
+	// List login events
+	// (GET /login-events)
+	GetLoginEvents(ctx echo.Context, params GetLoginEventsParams) error
 	// Login user by telegram mini app auth data
 	// (GET /miniapp/callback)
 	GetMiniappCallback(ctx echo.Context, params GetMiniappCallbackParams) error
+	// Receive Telegram bot updates
+	// (POST /telegram/webhook/{bot_id})
+	PostTelegramWebhookBotId(ctx echo.Context, botId int64, params PostTelegramWebhookBotIdParams) error
+	// Erase data of a Telegram user
+	// (DELETE /users/{user_id})
+	DeleteUsersUserId(ctx echo.Context, userId int64) error
+	// Export data of a Telegram user
+	// (GET /users/{user_id}/export)
+	GetUsersUserIdExport(ctx echo.Context, userId int64) error
 	// Login user by telegram widget auth data
 	// (GET /widget/callback)
 	GetWidgetCallback(ctx echo.Context, params GetWidgetCallbackParams) error
@@ -300,21 +932,12 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostBots converts echo context to params.
-func (w *ServerInterfaceWrapper) PostBots(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostBots(ctx)
-	return err
-}
-
-// GetMiniappCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMiniappCallback(ctx echo.Context) error {
+// GetBotCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotCallback(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetMiniappCallbackParams
+	var params GetBotCallbackParams
 	// ------------- Required query parameter "login_challenge" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "login_challenge", ctx.QueryParams(), &params.LoginChallenge)
@@ -322,11 +945,11 @@ func (w *ServerInterfaceWrapper) GetMiniappCallback(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login_challenge: %s", err))
 	}
 
-	// ------------- Required query parameter "telegram_mini_app_auth_data" -------------
+	// ------------- Required query parameter "nonce" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "telegram_mini_app_auth_data", ctx.QueryParams(), &params.TelegramMiniAppAuthData)
+	err = runtime.BindQueryParameter("form", true, true, "nonce", ctx.QueryParams(), &params.Nonce)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter telegram_mini_app_auth_data: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nonce: %s", err))
 	}
 
 	headers := ctx.Request().Header
@@ -362,263 +985,2844 @@ func (w *ServerInterfaceWrapper) GetMiniappCallback(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMiniappCallback(ctx, params)
+	err = w.Handler.GetBotCallback(ctx, params)
 	return err
 }
 
-// GetWidgetCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) GetWidgetCallback(ctx echo.Context) error {
+// GetBots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBots(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(AdminApiKeyScopes, []string{"bots:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:read"})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetWidgetCallbackParams
-	// ------------- Required query parameter "login_challenge" -------------
+	var params GetBotsParams
+	// ------------- Optional query parameter "linked" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "login_challenge", ctx.QueryParams(), &params.LoginChallenge)
+	err = runtime.BindQueryParameter("form", true, false, "linked", ctx.QueryParams(), &params.Linked)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login_challenge: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter linked: %s", err))
 	}
 
-	// ------------- Required query parameter "telegram_widget_auth_data" -------------
+	// ------------- Optional query parameter "username_prefix" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "telegram_widget_auth_data", ctx.QueryParams(), &params.TelegramWidgetAuthData)
+	err = runtime.BindQueryParameter("form", true, false, "username_prefix", ctx.QueryParams(), &params.UsernamePrefix)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter telegram_widget_auth_data: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username_prefix: %s", err))
 	}
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "User-Agent" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
-		var UserAgent string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for User-Agent, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", valueList[0], &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter User-Agent: %s", err))
-		}
+	// ------------- Optional query parameter "cursor" -------------
 
-		params.UserAgent = &UserAgent
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	// ------------- Optional header parameter "Accept-Language" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
-		var AcceptLanguage string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
-		}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
-		}
+	// ------------- Optional query parameter "limit" -------------
 
-		params.AcceptLanguage = &AcceptLanguage
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetWidgetCallback(ctx, params)
+	err = w.Handler.GetBots(ctx, params)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostBots converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBots(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBots(ctx)
+	return err
+}
+
+// DeleteBotsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBotsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBotsId(ctx, id)
+	return err
+}
+
+// GetBotsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsId(ctx, id)
+	return err
+}
+
+// DeleteBotsIdAccessPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBotsIdAccessPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBotsIdAccessPolicy(ctx, id)
+	return err
+}
+
+// GetBotsIdAccessPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsIdAccessPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsIdAccessPolicy(ctx, id)
+	return err
+}
+
+// PutBotsIdAccessPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBotsIdAccessPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutBotsIdAccessPolicy(ctx, id)
+	return err
+}
+
+// GetBotsIdChats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsIdChats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsIdChats(ctx, id)
+	return err
+}
+
+// PutBotsIdChats converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBotsIdChats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutBotsIdChats(ctx, id)
+	return err
+}
+
+// GetBotsIdClaimMappings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsIdClaimMappings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsIdClaimMappings(ctx, id)
+	return err
+}
+
+// PutBotsIdClaimMappings converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBotsIdClaimMappings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutBotsIdClaimMappings(ctx, id)
+	return err
+}
+
+// DeleteBotsIdClient converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBotsIdClient(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBotsIdClient(ctx, id)
+	return err
+}
+
+// PutBotsIdClient converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBotsIdClient(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutBotsIdClient(ctx, id)
+	return err
+}
+
+// PostBotsIdClients converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBotsIdClients(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBotsIdClients(ctx, id)
+	return err
+}
+
+// PutBotsIdStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBotsIdStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"bots:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"bots:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutBotsIdStatus(ctx, id)
+	return err
+}
+
+// GetBotsIdUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsIdUsers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBotsIdUsersParams
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsIdUsers(ctx, id, params)
+	return err
+}
+
+// DeleteBotsIdUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBotsIdUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBotsIdUsersUserId(ctx, id, userId)
+	return err
+}
+
+// GetBotsIdUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBotsIdUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBotsIdUsersUserId(ctx, id, userId)
+	return err
+}
+
+// GetLoginEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLoginEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLoginEventsParams
+	// ------------- Optional query parameter "bot_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bot_id", ctx.QueryParams(), &params.BotId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bot_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "client_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "client_id", ctx.QueryParams(), &params.ClientId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", ctx.QueryParams(), &params.Outcome)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter outcome: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLoginEvents(ctx, params)
+	return err
+}
+
+// GetMiniappCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMiniappCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMiniappCallbackParams
+	// ------------- Required query parameter "login_challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "login_challenge", ctx.QueryParams(), &params.LoginChallenge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login_challenge: %s", err))
+	}
+
+	// ------------- Required query parameter "init_data" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "init_data", ctx.QueryParams(), &params.InitData)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter init_data: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "User-Agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for User-Agent, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", valueList[0], &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter User-Agent: %s", err))
+		}
+
+		params.UserAgent = &UserAgent
+	}
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMiniappCallback(ctx, params)
+	return err
+}
+
+// PostTelegramWebhookBotId converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTelegramWebhookBotId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bot_id" -------------
+	var botId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bot_id", ctx.Param("bot_id"), &botId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bot_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTelegramWebhookBotIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Telegram-Bot-Api-Secret-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Telegram-Bot-Api-Secret-Token")]; found {
+		var XTelegramBotApiSecretToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Telegram-Bot-Api-Secret-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Telegram-Bot-Api-Secret-Token", valueList[0], &XTelegramBotApiSecretToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Telegram-Bot-Api-Secret-Token: %s", err))
+		}
+
+		params.XTelegramBotApiSecretToken = XTelegramBotApiSecretToken
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Telegram-Bot-Api-Secret-Token is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTelegramWebhookBotId(ctx, botId, params)
+	return err
+}
+
+// DeleteUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:write"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersUserId(ctx, userId)
+	return err
+}
+
+// GetUsersUserIdExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{"users:read"})
+
+	ctx.Set(AdminSignatureScopes, []string{"users:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdExport(ctx, userId)
+	return err
+}
+
+// GetWidgetCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWidgetCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWidgetCallbackParams
+	// ------------- Required query parameter "login_challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "login_challenge", ctx.QueryParams(), &params.LoginChallenge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login_challenge: %s", err))
+	}
+
+	// ------------- Required query parameter "telegram_widget_auth_data" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "telegram_widget_auth_data", ctx.QueryParams(), &params.TelegramWidgetAuthData)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter telegram_widget_auth_data: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "User-Agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for User-Agent, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", valueList[0], &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter User-Agent: %s", err))
+		}
+
+		params.UserAgent = &UserAgent
+	}
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Accept-Language, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Accept-Language: %s", err))
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWidgetCallback(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/bot/callback", wrapper.GetBotCallback)
+	router.GET(baseURL+"/bots", wrapper.GetBots)
+	router.POST(baseURL+"/bots", wrapper.PostBots)
+	router.DELETE(baseURL+"/bots/:id", wrapper.DeleteBotsId)
+	router.GET(baseURL+"/bots/:id", wrapper.GetBotsId)
+	router.DELETE(baseURL+"/bots/:id/access-policy", wrapper.DeleteBotsIdAccessPolicy)
+	router.GET(baseURL+"/bots/:id/access-policy", wrapper.GetBotsIdAccessPolicy)
+	router.PUT(baseURL+"/bots/:id/access-policy", wrapper.PutBotsIdAccessPolicy)
+	router.GET(baseURL+"/bots/:id/chats", wrapper.GetBotsIdChats)
+	router.PUT(baseURL+"/bots/:id/chats", wrapper.PutBotsIdChats)
+	router.GET(baseURL+"/bots/:id/claim-mappings", wrapper.GetBotsIdClaimMappings)
+	router.PUT(baseURL+"/bots/:id/claim-mappings", wrapper.PutBotsIdClaimMappings)
+	router.DELETE(baseURL+"/bots/:id/client", wrapper.DeleteBotsIdClient)
+	router.PUT(baseURL+"/bots/:id/client", wrapper.PutBotsIdClient)
+	router.POST(baseURL+"/bots/:id/clients", wrapper.PostBotsIdClients)
+	router.PUT(baseURL+"/bots/:id/status", wrapper.PutBotsIdStatus)
+	router.GET(baseURL+"/bots/:id/users", wrapper.GetBotsIdUsers)
+	router.DELETE(baseURL+"/bots/:id/users/:user_id", wrapper.DeleteBotsIdUsersUserId)
+	router.GET(baseURL+"/bots/:id/users/:user_id", wrapper.GetBotsIdUsersUserId)
+	router.GET(baseURL+"/login-events", wrapper.GetLoginEvents)
+	router.GET(baseURL+"/miniapp/callback", wrapper.GetMiniappCallback)
+	router.POST(baseURL+"/telegram/webhook/:bot_id", wrapper.PostTelegramWebhookBotId)
+	router.DELETE(baseURL+"/users/:user_id", wrapper.DeleteUsersUserId)
+	router.GET(baseURL+"/users/:user_id/export", wrapper.GetUsersUserIdExport)
+	router.GET(baseURL+"/widget/callback", wrapper.GetWidgetCallback)
+
+}
+
+type ForbiddenJSONResponse ErrorResponse
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type GetBotCallbackRequestObject struct {
+	Params GetBotCallbackParams
+}
+
+type GetBotCallbackResponseObject interface {
+	VisitGetBotCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetBotCallback202Response struct {
+}
+
+func (response GetBotCallback202Response) VisitGetBotCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type GetBotCallback203ResponseHeaders struct {
+	Location string
+}
+
+type GetBotCallback203Response struct {
+	Headers GetBotCallback203ResponseHeaders
+}
+
+func (response GetBotCallback203Response) VisitGetBotCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(203)
+	return nil
+}
+
+type GetBotsRequestObject struct {
+	Params GetBotsParams
+}
+
+type GetBotsResponseObject interface {
+	VisitGetBotsResponse(w http.ResponseWriter) error
+}
+
+type GetBots200JSONResponse BotListResponse
+
+func (response GetBots200JSONResponse) VisitGetBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBots400JSONResponse ErrorResponse
+
+func (response GetBots400JSONResponse) VisitGetBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBots401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBots401JSONResponse) VisitGetBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBots403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBots403JSONResponse) VisitGetBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBots500JSONResponse ErrorResponse
+
+func (response GetBots500JSONResponse) VisitGetBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsRequestObject struct {
+	Body *PostBotsJSONRequestBody
+}
+
+type PostBotsResponseObject interface {
+	VisitPostBotsResponse(w http.ResponseWriter) error
+}
+
+type PostBots200ResponseHeaders struct {
+	Location string
+}
+
+type PostBots200JSONResponse struct {
+	Body struct {
+		Id int64 `json:"id"`
+
+		// LastSyncedAt Timestamp of the last successful sync with Telegram
+		LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+
+		// Updated Indicates whether the bot was updated (true) or not (false)
+		Updated *bool `json:"updated,omitempty"`
+	}
+	Headers PostBots200ResponseHeaders
+}
+
+func (response PostBots200JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostBots201ResponseHeaders struct {
+	Location string
+}
+
+type PostBots201JSONResponse struct {
+	Body struct {
+		Id int64 `json:"id"`
+	}
+	Headers PostBots201ResponseHeaders
+}
+
+func (response PostBots201JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostBots400JSONResponse ErrorResponse
+
+func (response PostBots400JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBots401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostBots401JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBots403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostBots403JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBots429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostBots429JSONResponse struct {
+	Body    ErrorResponse
+	Headers PostBots429ResponseHeaders
+}
+
+func (response PostBots429JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostBots500JSONResponse ErrorResponse
+
+func (response PostBots500JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteBotsIdResponseObject interface {
+	VisitDeleteBotsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteBotsId204Response struct {
+}
+
+func (response DeleteBotsId204Response) VisitDeleteBotsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBotsId401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteBotsId401JSONResponse) VisitDeleteBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteBotsId403JSONResponse) VisitDeleteBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsId404JSONResponse ErrorResponse
+
+func (response DeleteBotsId404JSONResponse) VisitDeleteBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsId500JSONResponse ErrorResponse
+
+func (response DeleteBotsId500JSONResponse) VisitDeleteBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetBotsIdResponseObject interface {
+	VisitGetBotsIdResponse(w http.ResponseWriter) error
+}
+
+type GetBotsId200JSONResponse BotResponse
+
+func (response GetBotsId200JSONResponse) VisitGetBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsId401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsId401JSONResponse) VisitGetBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsId403JSONResponse) VisitGetBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsId404JSONResponse ErrorResponse
+
+func (response GetBotsId404JSONResponse) VisitGetBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsId500JSONResponse ErrorResponse
+
+func (response GetBotsId500JSONResponse) VisitGetBotsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdAccessPolicyRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteBotsIdAccessPolicyResponseObject interface {
+	VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteBotsIdAccessPolicy204Response struct {
+}
+
+func (response DeleteBotsIdAccessPolicy204Response) VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBotsIdAccessPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteBotsIdAccessPolicy401JSONResponse) VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdAccessPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteBotsIdAccessPolicy403JSONResponse) VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdAccessPolicy404JSONResponse ErrorResponse
+
+func (response DeleteBotsIdAccessPolicy404JSONResponse) VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdAccessPolicy500JSONResponse ErrorResponse
+
+func (response DeleteBotsIdAccessPolicy500JSONResponse) VisitDeleteBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdAccessPolicyRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetBotsIdAccessPolicyResponseObject interface {
+	VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetBotsIdAccessPolicy200JSONResponse BotAccessPolicyResponse
+
+func (response GetBotsIdAccessPolicy200JSONResponse) VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdAccessPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsIdAccessPolicy401JSONResponse) VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdAccessPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsIdAccessPolicy403JSONResponse) VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdAccessPolicy404JSONResponse ErrorResponse
+
+func (response GetBotsIdAccessPolicy404JSONResponse) VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdAccessPolicy500JSONResponse ErrorResponse
+
+func (response GetBotsIdAccessPolicy500JSONResponse) VisitGetBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicyRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutBotsIdAccessPolicyJSONRequestBody
+}
+
+type PutBotsIdAccessPolicyResponseObject interface {
+	VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error
+}
+
+type PutBotsIdAccessPolicy200JSONResponse BotAccessPolicyResponse
+
+func (response PutBotsIdAccessPolicy200JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicy400JSONResponse ErrorResponse
+
+func (response PutBotsIdAccessPolicy400JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutBotsIdAccessPolicy401JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutBotsIdAccessPolicy403JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicy404JSONResponse ErrorResponse
+
+func (response PutBotsIdAccessPolicy404JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdAccessPolicy500JSONResponse ErrorResponse
+
+func (response PutBotsIdAccessPolicy500JSONResponse) VisitPutBotsIdAccessPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdChatsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetBotsIdChatsResponseObject interface {
+	VisitGetBotsIdChatsResponse(w http.ResponseWriter) error
+}
+
+type GetBotsIdChats200JSONResponse BotChatList
+
+func (response GetBotsIdChats200JSONResponse) VisitGetBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdChats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsIdChats401JSONResponse) VisitGetBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdChats403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsIdChats403JSONResponse) VisitGetBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdChats404JSONResponse ErrorResponse
+
+func (response GetBotsIdChats404JSONResponse) VisitGetBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdChats500JSONResponse ErrorResponse
+
+func (response GetBotsIdChats500JSONResponse) VisitGetBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChatsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutBotsIdChatsJSONRequestBody
+}
+
+type PutBotsIdChatsResponseObject interface {
+	VisitPutBotsIdChatsResponse(w http.ResponseWriter) error
+}
+
+type PutBotsIdChats200JSONResponse BotChatList
+
+func (response PutBotsIdChats200JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChats400JSONResponse ErrorResponse
+
+func (response PutBotsIdChats400JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutBotsIdChats401JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChats403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutBotsIdChats403JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChats404JSONResponse ErrorResponse
+
+func (response PutBotsIdChats404JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdChats500JSONResponse ErrorResponse
+
+func (response PutBotsIdChats500JSONResponse) VisitPutBotsIdChatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdClaimMappingsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetBotsIdClaimMappingsResponseObject interface {
+	VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error
+}
+
+type GetBotsIdClaimMappings200JSONResponse BotClaimMappingList
+
+func (response GetBotsIdClaimMappings200JSONResponse) VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdClaimMappings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsIdClaimMappings401JSONResponse) VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdClaimMappings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsIdClaimMappings403JSONResponse) VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdClaimMappings404JSONResponse ErrorResponse
+
+func (response GetBotsIdClaimMappings404JSONResponse) VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdClaimMappings500JSONResponse ErrorResponse
+
+func (response GetBotsIdClaimMappings500JSONResponse) VisitGetBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappingsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutBotsIdClaimMappingsJSONRequestBody
+}
+
+type PutBotsIdClaimMappingsResponseObject interface {
+	VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error
+}
+
+type PutBotsIdClaimMappings200JSONResponse BotClaimMappingList
+
+func (response PutBotsIdClaimMappings200JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappings400JSONResponse ErrorResponse
+
+func (response PutBotsIdClaimMappings400JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutBotsIdClaimMappings401JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutBotsIdClaimMappings403JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappings404JSONResponse ErrorResponse
+
+func (response PutBotsIdClaimMappings404JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClaimMappings500JSONResponse ErrorResponse
+
+func (response PutBotsIdClaimMappings500JSONResponse) VisitPutBotsIdClaimMappingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdClientRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteBotsIdClientResponseObject interface {
+	VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error
+}
+
+type DeleteBotsIdClient204Response struct {
+}
+
+func (response DeleteBotsIdClient204Response) VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBotsIdClient401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteBotsIdClient401JSONResponse) VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdClient403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteBotsIdClient403JSONResponse) VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdClient404JSONResponse ErrorResponse
+
+func (response DeleteBotsIdClient404JSONResponse) VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdClient500JSONResponse ErrorResponse
+
+func (response DeleteBotsIdClient500JSONResponse) VisitDeleteBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClientRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutBotsIdClientJSONRequestBody
+}
+
+type PutBotsIdClientResponseObject interface {
+	VisitPutBotsIdClientResponse(w http.ResponseWriter) error
+}
+
+type PutBotsIdClient200JSONResponse struct {
+	ClientId string `json:"client_id"`
+	Id       int64  `json:"id"`
+
+	// Updated Indicates whether the link was changed (true) or already existed (false)
+	Updated *bool `json:"updated,omitempty"`
+}
+
+func (response PutBotsIdClient200JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient400JSONResponse ErrorResponse
+
+func (response PutBotsIdClient400JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutBotsIdClient401JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutBotsIdClient403JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient404JSONResponse ErrorResponse
+
+func (response PutBotsIdClient404JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient409JSONResponse ErrorResponse
+
+func (response PutBotsIdClient409JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient500JSONResponse ErrorResponse
+
+func (response PutBotsIdClient500JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient502JSONResponse ErrorResponse
+
+func (response PutBotsIdClient502JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdClient504JSONResponse ErrorResponse
+
+func (response PutBotsIdClient504JSONResponse) VisitPutBotsIdClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClientsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostBotsIdClientsJSONRequestBody
+}
+
+type PostBotsIdClientsResponseObject interface {
+	VisitPostBotsIdClientsResponse(w http.ResponseWriter) error
+}
+
+type PostBotsIdClients201ResponseHeaders struct {
+	Location string
+}
+
+type PostBotsIdClients201JSONResponse struct {
+	Body struct {
+		ClientId   string `json:"client_id"`
+		ClientName string `json:"client_name"`
+
+		// ClientSecret Client secret. Returned only once, absent for public clients.
+		ClientSecret *string  `json:"client_secret,omitempty"`
+		GrantTypes   []string `json:"grant_types"`
+
+		// Id Bot ID
+		Id                      int64    `json:"id"`
+		RedirectUris            []string `json:"redirect_uris"`
+		Scopes                  []string `json:"scopes"`
+		TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"`
+	}
+	Headers PostBotsIdClients201ResponseHeaders
+}
+
+func (response PostBotsIdClients201JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostBotsIdClients400JSONResponse ErrorResponse
+
+func (response PostBotsIdClients400JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostBotsIdClients401JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostBotsIdClients403JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients404JSONResponse ErrorResponse
+
+func (response PostBotsIdClients404JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients409JSONResponse ErrorResponse
+
+func (response PostBotsIdClients409JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients500JSONResponse ErrorResponse
+
+func (response PostBotsIdClients500JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients502JSONResponse ErrorResponse
+
+func (response PostBotsIdClients502JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBotsIdClients504JSONResponse ErrorResponse
+
+func (response PostBotsIdClients504JSONResponse) VisitPostBotsIdClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatusRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutBotsIdStatusJSONRequestBody
+}
+
+type PutBotsIdStatusResponseObject interface {
+	VisitPutBotsIdStatusResponse(w http.ResponseWriter) error
+}
+
+type PutBotsIdStatus200JSONResponse BotStatusResponse
+
+func (response PutBotsIdStatus200JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatus400JSONResponse ErrorResponse
+
+func (response PutBotsIdStatus400JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutBotsIdStatus401JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutBotsIdStatus403JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatus404JSONResponse ErrorResponse
+
+func (response PutBotsIdStatus404JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBotsIdStatus500JSONResponse ErrorResponse
+
+func (response PutBotsIdStatus500JSONResponse) VisitPutBotsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetBotsIdUsersParams
+}
+
+type GetBotsIdUsersResponseObject interface {
+	VisitGetBotsIdUsersResponse(w http.ResponseWriter) error
+}
+
+type GetBotsIdUsers200JSONResponse BotUserListResponse
+
+func (response GetBotsIdUsers200JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsers400JSONResponse ErrorResponse
+
+func (response GetBotsIdUsers400JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsIdUsers401JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsIdUsers403JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsers404JSONResponse ErrorResponse
+
+func (response GetBotsIdUsers404JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsers500JSONResponse ErrorResponse
+
+func (response GetBotsIdUsers500JSONResponse) VisitGetBotsIdUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdUsersUserIdRequestObject struct {
+	Id     int64 `json:"id"`
+	UserId int64 `json:"user_id"`
+}
+
+type DeleteBotsIdUsersUserIdResponseObject interface {
+	VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteBotsIdUsersUserId204Response struct {
+}
+
+func (response DeleteBotsIdUsersUserId204Response) VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBotsIdUsersUserId401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteBotsIdUsersUserId401JSONResponse) VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdUsersUserId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteBotsIdUsersUserId403JSONResponse) VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdUsersUserId404JSONResponse ErrorResponse
+
+func (response DeleteBotsIdUsersUserId404JSONResponse) VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBotsIdUsersUserId500JSONResponse ErrorResponse
+
+func (response DeleteBotsIdUsersUserId500JSONResponse) VisitDeleteBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersUserIdRequestObject struct {
+	Id     int64 `json:"id"`
+	UserId int64 `json:"user_id"`
+}
+
+type GetBotsIdUsersUserIdResponseObject interface {
+	VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetBotsIdUsersUserId200JSONResponse BotUserResponse
+
+func (response GetBotsIdUsersUserId200JSONResponse) VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersUserId401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetBotsIdUsersUserId401JSONResponse) VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersUserId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetBotsIdUsersUserId403JSONResponse) VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersUserId404JSONResponse ErrorResponse
+
+func (response GetBotsIdUsersUserId404JSONResponse) VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBotsIdUsersUserId500JSONResponse ErrorResponse
+
+func (response GetBotsIdUsersUserId500JSONResponse) VisitGetBotsIdUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLoginEventsRequestObject struct {
+	Params GetLoginEventsParams
+}
+
+type GetLoginEventsResponseObject interface {
+	VisitGetLoginEventsResponse(w http.ResponseWriter) error
+}
+
+type GetLoginEvents200JSONResponse LoginEventListResponse
+
+func (response GetLoginEvents200JSONResponse) VisitGetLoginEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLoginEvents400JSONResponse ErrorResponse
+
+func (response GetLoginEvents400JSONResponse) VisitGetLoginEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLoginEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetLoginEvents401JSONResponse) VisitGetLoginEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLoginEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetLoginEvents403JSONResponse) VisitGetLoginEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLoginEvents500JSONResponse ErrorResponse
+
+func (response GetLoginEvents500JSONResponse) VisitGetLoginEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMiniappCallbackRequestObject struct {
+	Params GetMiniappCallbackParams
+}
+
+type GetMiniappCallbackResponseObject interface {
+	VisitGetMiniappCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetMiniappCallback203ResponseHeaders struct {
+	Location string
+}
+
+type GetMiniappCallback203Response struct {
+	Headers GetMiniappCallback203ResponseHeaders
+}
+
+func (response GetMiniappCallback203Response) VisitGetMiniappCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(203)
+	return nil
+}
+
+type PostTelegramWebhookBotIdRequestObject struct {
+	BotId  int64 `json:"bot_id"`
+	Params PostTelegramWebhookBotIdParams
+	Body   *PostTelegramWebhookBotIdJSONRequestBody
+}
+
+type PostTelegramWebhookBotIdResponseObject interface {
+	VisitPostTelegramWebhookBotIdResponse(w http.ResponseWriter) error
+}
+
+type PostTelegramWebhookBotId200JSONResponse TelegramWebhookReply
+
+func (response PostTelegramWebhookBotId200JSONResponse) VisitPostTelegramWebhookBotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTelegramWebhookBotId400JSONResponse ErrorResponse
+
+func (response PostTelegramWebhookBotId400JSONResponse) VisitPostTelegramWebhookBotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTelegramWebhookBotId404JSONResponse ErrorResponse
+
+func (response PostTelegramWebhookBotId404JSONResponse) VisitPostTelegramWebhookBotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdRequestObject struct {
+	UserId int64 `json:"user_id"`
+}
+
+type DeleteUsersUserIdResponseObject interface {
+	VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserId200JSONResponse UserDataErasure
+
+func (response DeleteUsersUserId200JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId400JSONResponse ErrorResponse
+
+func (response DeleteUsersUserId400JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteUsersUserId401JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteUsersUserId403JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId500JSONResponse ErrorResponse
+
+func (response DeleteUsersUserId500JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId502JSONResponse ErrorResponse
+
+func (response DeleteUsersUserId502JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserId504JSONResponse ErrorResponse
+
+func (response DeleteUsersUserId504JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExportRequestObject struct {
+	UserId int64 `json:"user_id"`
+}
+
+type GetUsersUserIdExportResponseObject interface {
+	VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdExport200JSONResponse UserDataExport
+
+func (response GetUsersUserIdExport200JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport400JSONResponse ErrorResponse
+
+func (response GetUsersUserIdExport400JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUsersUserIdExport401JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUsersUserIdExport403JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport500JSONResponse ErrorResponse
+
+func (response GetUsersUserIdExport500JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport502JSONResponse ErrorResponse
+
+func (response GetUsersUserIdExport502JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdExport504JSONResponse ErrorResponse
+
+func (response GetUsersUserIdExport504JSONResponse) VisitGetUsersUserIdExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWidgetCallbackRequestObject struct {
+	Params GetWidgetCallbackParams
+}
+
+type GetWidgetCallbackResponseObject interface {
+	VisitGetWidgetCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetWidgetCallback203ResponseHeaders struct {
+	Location string
+}
+
+type GetWidgetCallback203Response struct {
+	Headers GetWidgetCallback203ResponseHeaders
+}
+
+func (response GetWidgetCallback203Response) VisitGetWidgetCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(203)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Complete login confirmed in the Telegram bot
+	// (GET /bot/callback)
+	GetBotCallback(ctx context.Context, request GetBotCallbackRequestObject) (GetBotCallbackResponseObject, error)
+	// List registered bots
+	// (GET /bots)
+	GetBots(ctx context.Context, request GetBotsRequestObject) (GetBotsResponseObject, error)
+	// Sync Telegram bot by token
+	// (POST /bots)
+	PostBots(ctx context.Context, request PostBotsRequestObject) (PostBotsResponseObject, error)
+	// Delete bot
+	// (DELETE /bots/{id})
+	DeleteBotsId(ctx context.Context, request DeleteBotsIdRequestObject) (DeleteBotsIdResponseObject, error)
+	// Get bot
+	// (GET /bots/{id})
+	GetBotsId(ctx context.Context, request GetBotsIdRequestObject) (GetBotsIdResponseObject, error)
+	// Delete access policy of the bot
+	// (DELETE /bots/{id}/access-policy)
+	DeleteBotsIdAccessPolicy(ctx context.Context, request DeleteBotsIdAccessPolicyRequestObject) (DeleteBotsIdAccessPolicyResponseObject, error)
+	// Get access policy of the bot
+	// (GET /bots/{id}/access-policy)
+	GetBotsIdAccessPolicy(ctx context.Context, request GetBotsIdAccessPolicyRequestObject) (GetBotsIdAccessPolicyResponseObject, error)
+	// Set access policy of the bot
+	// (PUT /bots/{id}/access-policy)
+	PutBotsIdAccessPolicy(ctx context.Context, request PutBotsIdAccessPolicyRequestObject) (PutBotsIdAccessPolicyResponseObject, error)
+	// List Telegram chats of the bot
+	// (GET /bots/{id}/chats)
+	GetBotsIdChats(ctx context.Context, request GetBotsIdChatsRequestObject) (GetBotsIdChatsResponseObject, error)
+	// Replace Telegram chats of the bot
+	// (PUT /bots/{id}/chats)
+	PutBotsIdChats(ctx context.Context, request PutBotsIdChatsRequestObject) (PutBotsIdChatsResponseObject, error)
+	// List scope to claim mappings of the bot
+	// (GET /bots/{id}/claim-mappings)
+	GetBotsIdClaimMappings(ctx context.Context, request GetBotsIdClaimMappingsRequestObject) (GetBotsIdClaimMappingsResponseObject, error)
+	// Replace scope to claim mappings of the bot
+	// (PUT /bots/{id}/claim-mappings)
+	PutBotsIdClaimMappings(ctx context.Context, request PutBotsIdClaimMappingsRequestObject) (PutBotsIdClaimMappingsResponseObject, error)
+	// Unlink bot from OIDC client
+	// (DELETE /bots/{id}/client)
+	DeleteBotsIdClient(ctx context.Context, request DeleteBotsIdClientRequestObject) (DeleteBotsIdClientResponseObject, error)
+	// Link bot to OIDC client
+	// (PUT /bots/{id}/client)
+	PutBotsIdClient(ctx context.Context, request PutBotsIdClientRequestObject) (PutBotsIdClientResponseObject, error)
+	// Create OIDC client for bot
+	// (POST /bots/{id}/clients)
+	PostBotsIdClients(ctx context.Context, request PostBotsIdClientsRequestObject) (PostBotsIdClientsResponseObject, error)
+	// Set lifecycle status of the bot
+	// (PUT /bots/{id}/status)
+	PutBotsIdStatus(ctx context.Context, request PutBotsIdStatusRequestObject) (PutBotsIdStatusResponseObject, error)
+	// List users signed in through the bot
+	// (GET /bots/{id}/users)
+	GetBotsIdUsers(ctx context.Context, request GetBotsIdUsersRequestObject) (GetBotsIdUsersResponseObject, error)
+	// Delete bot user
+	// (DELETE /bots/{id}/users/{user_id})
+	DeleteBotsIdUsersUserId(ctx context.Context, request DeleteBotsIdUsersUserIdRequestObject) (DeleteBotsIdUsersUserIdResponseObject, error)
+	// Get bot user
+	// (GET /bots/{id}/users/{user_id})
+	GetBotsIdUsersUserId(ctx context.Context, request GetBotsIdUsersUserIdRequestObject) (GetBotsIdUsersUserIdResponseObject, error)
+	// List login events
+	// (GET /login-events)
+	GetLoginEvents(ctx context.Context, request GetLoginEventsRequestObject) (GetLoginEventsResponseObject, error)
+	// Login user by telegram mini app auth data
+	// (GET /miniapp/callback)
+	GetMiniappCallback(ctx context.Context, request GetMiniappCallbackRequestObject) (GetMiniappCallbackResponseObject, error)
+	// Receive Telegram bot updates
+	// (POST /telegram/webhook/{bot_id})
+	PostTelegramWebhookBotId(ctx context.Context, request PostTelegramWebhookBotIdRequestObject) (PostTelegramWebhookBotIdResponseObject, error)
+	// Erase data of a Telegram user
+	// (DELETE /users/{user_id})
+	DeleteUsersUserId(ctx context.Context, request DeleteUsersUserIdRequestObject) (DeleteUsersUserIdResponseObject, error)
+	// Export data of a Telegram user
+	// (GET /users/{user_id}/export)
+	GetUsersUserIdExport(ctx context.Context, request GetUsersUserIdExportRequestObject) (GetUsersUserIdExportResponseObject, error)
+	// Login user by telegram widget auth data
+	// (GET /widget/callback)
+	GetWidgetCallback(ctx context.Context, request GetWidgetCallbackRequestObject) (GetWidgetCallbackResponseObject, error)
+}
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+// GetBotCallback operation middleware
+func (sh *strictHandler) GetBotCallback(ctx echo.Context, params GetBotCallbackParams) error {
+	var request GetBotCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotCallback(ctx.Request().Context(), request.(GetBotCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotCallbackResponseObject); ok {
+		return validResponse.VisitGetBotCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBots operation middleware
+func (sh *strictHandler) GetBots(ctx echo.Context, params GetBotsParams) error {
+	var request GetBotsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBots(ctx.Request().Context(), request.(GetBotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBots")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsResponseObject); ok {
+		return validResponse.VisitGetBotsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostBots operation middleware
+func (sh *strictHandler) PostBots(ctx echo.Context) error {
+	var request PostBotsRequestObject
+
+	var body PostBotsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBots(ctx.Request().Context(), request.(PostBotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBots")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostBotsResponseObject); ok {
+		return validResponse.VisitPostBotsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBotsId operation middleware
+func (sh *strictHandler) DeleteBotsId(ctx echo.Context, id int64) error {
+	var request DeleteBotsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBotsId(ctx.Request().Context(), request.(DeleteBotsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBotsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBotsIdResponseObject); ok {
+		return validResponse.VisitDeleteBotsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBotsId operation middleware
+func (sh *strictHandler) GetBotsId(ctx echo.Context, id int64) error {
+	var request GetBotsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsId(ctx.Request().Context(), request.(GetBotsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsIdResponseObject); ok {
+		return validResponse.VisitGetBotsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBotsIdAccessPolicy operation middleware
+func (sh *strictHandler) DeleteBotsIdAccessPolicy(ctx echo.Context, id int64) error {
+	var request DeleteBotsIdAccessPolicyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBotsIdAccessPolicy(ctx.Request().Context(), request.(DeleteBotsIdAccessPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBotsIdAccessPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBotsIdAccessPolicyResponseObject); ok {
+		return validResponse.VisitDeleteBotsIdAccessPolicyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBotsIdAccessPolicy operation middleware
+func (sh *strictHandler) GetBotsIdAccessPolicy(ctx echo.Context, id int64) error {
+	var request GetBotsIdAccessPolicyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsIdAccessPolicy(ctx.Request().Context(), request.(GetBotsIdAccessPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsIdAccessPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsIdAccessPolicyResponseObject); ok {
+		return validResponse.VisitGetBotsIdAccessPolicyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutBotsIdAccessPolicy operation middleware
+func (sh *strictHandler) PutBotsIdAccessPolicy(ctx echo.Context, id int64) error {
+	var request PutBotsIdAccessPolicyRequestObject
+
+	request.Id = id
+
+	var body PutBotsIdAccessPolicyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBotsIdAccessPolicy(ctx.Request().Context(), request.(PutBotsIdAccessPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBotsIdAccessPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutBotsIdAccessPolicyResponseObject); ok {
+		return validResponse.VisitPutBotsIdAccessPolicyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBotsIdChats operation middleware
+func (sh *strictHandler) GetBotsIdChats(ctx echo.Context, id int64) error {
+	var request GetBotsIdChatsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsIdChats(ctx.Request().Context(), request.(GetBotsIdChatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsIdChats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsIdChatsResponseObject); ok {
+		return validResponse.VisitGetBotsIdChatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutBotsIdChats operation middleware
+func (sh *strictHandler) PutBotsIdChats(ctx echo.Context, id int64) error {
+	var request PutBotsIdChatsRequestObject
+
+	request.Id = id
+
+	var body PutBotsIdChatsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBotsIdChats(ctx.Request().Context(), request.(PutBotsIdChatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBotsIdChats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutBotsIdChatsResponseObject); ok {
+		return validResponse.VisitPutBotsIdChatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBotsIdClaimMappings operation middleware
+func (sh *strictHandler) GetBotsIdClaimMappings(ctx echo.Context, id int64) error {
+	var request GetBotsIdClaimMappingsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsIdClaimMappings(ctx.Request().Context(), request.(GetBotsIdClaimMappingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsIdClaimMappings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsIdClaimMappingsResponseObject); ok {
+		return validResponse.VisitGetBotsIdClaimMappingsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutBotsIdClaimMappings operation middleware
+func (sh *strictHandler) PutBotsIdClaimMappings(ctx echo.Context, id int64) error {
+	var request PutBotsIdClaimMappingsRequestObject
+
+	request.Id = id
+
+	var body PutBotsIdClaimMappingsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBotsIdClaimMappings(ctx.Request().Context(), request.(PutBotsIdClaimMappingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBotsIdClaimMappings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutBotsIdClaimMappingsResponseObject); ok {
+		return validResponse.VisitPutBotsIdClaimMappingsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBotsIdClient operation middleware
+func (sh *strictHandler) DeleteBotsIdClient(ctx echo.Context, id int64) error {
+	var request DeleteBotsIdClientRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBotsIdClient(ctx.Request().Context(), request.(DeleteBotsIdClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBotsIdClient")
 	}
 
-	router.POST(baseURL+"/bots", wrapper.PostBots)
-	router.GET(baseURL+"/miniapp/callback", wrapper.GetMiniappCallback)
-	router.GET(baseURL+"/widget/callback", wrapper.GetWidgetCallback)
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBotsIdClientResponseObject); ok {
+		return validResponse.VisitDeleteBotsIdClientResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostBotsRequestObject struct {
-	Body *PostBotsJSONRequestBody
-}
+// PutBotsIdClient operation middleware
+func (sh *strictHandler) PutBotsIdClient(ctx echo.Context, id int64) error {
+	var request PutBotsIdClientRequestObject
 
-type PostBotsResponseObject interface {
-	VisitPostBotsResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type PostBots200ResponseHeaders struct {
-	Location string
-}
+	var body PutBotsIdClientJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PostBots200JSONResponse struct {
-	Body struct {
-		Id int64 `json:"id"`
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBotsIdClient(ctx.Request().Context(), request.(PutBotsIdClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBotsIdClient")
+	}
 
-		// LastSyncedAt Timestamp of the last successful sync with Telegram
-		LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+	response, err := handler(ctx, request)
 
-		// Updated Indicates whether the bot was updated (true) or not (false)
-		Updated *bool `json:"updated,omitempty"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutBotsIdClientResponseObject); ok {
+		return validResponse.VisitPutBotsIdClientResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	Headers PostBots200ResponseHeaders
+	return nil
 }
 
-func (response PostBots200JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(200)
+// PostBotsIdClients operation middleware
+func (sh *strictHandler) PostBotsIdClients(ctx echo.Context, id int64) error {
+	var request PostBotsIdClientsRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.Id = id
 
-type PostBots201ResponseHeaders struct {
-	Location string
-}
+	var body PostBotsIdClientsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PostBots201JSONResponse struct {
-	Body struct {
-		Id int64 `json:"id"`
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBotsIdClients(ctx.Request().Context(), request.(PostBotsIdClientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBotsIdClients")
 	}
-	Headers PostBots201ResponseHeaders
-}
 
-func (response PostBots201JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostBotsIdClientsResponseObject); ok {
+		return validResponse.VisitPostBotsIdClientsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostBots400JSONResponse ErrorResponse
+// PutBotsIdStatus operation middleware
+func (sh *strictHandler) PutBotsIdStatus(ctx echo.Context, id int64) error {
+	var request PutBotsIdStatusRequestObject
 
-func (response PostBots400JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutBotsIdStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PostBots500JSONResponse ErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBotsIdStatus(ctx.Request().Context(), request.(PutBotsIdStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBotsIdStatus")
+	}
 
-func (response PostBots500JSONResponse) VisitPostBotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutBotsIdStatusResponseObject); ok {
+		return validResponse.VisitPutBotsIdStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetMiniappCallbackRequestObject struct {
-	Params GetMiniappCallbackParams
-}
+// GetBotsIdUsers operation middleware
+func (sh *strictHandler) GetBotsIdUsers(ctx echo.Context, id int64, params GetBotsIdUsersParams) error {
+	var request GetBotsIdUsersRequestObject
 
-type GetMiniappCallbackResponseObject interface {
-	VisitGetMiniappCallbackResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type GetMiniappCallback203ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsIdUsers(ctx.Request().Context(), request.(GetBotsIdUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsIdUsers")
+	}
 
-type GetMiniappCallback203Response struct {
-	Headers GetMiniappCallback203ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response GetMiniappCallback203Response) VisitGetMiniappCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(203)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBotsIdUsersResponseObject); ok {
+		return validResponse.VisitGetBotsIdUsersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type GetWidgetCallbackRequestObject struct {
-	Params GetWidgetCallbackParams
-}
+// DeleteBotsIdUsersUserId operation middleware
+func (sh *strictHandler) DeleteBotsIdUsersUserId(ctx echo.Context, id int64, userId int64) error {
+	var request DeleteBotsIdUsersUserIdRequestObject
 
-type GetWidgetCallbackResponseObject interface {
-	VisitGetWidgetCallbackResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.UserId = userId
 
-type GetWidgetCallback203ResponseHeaders struct {
-	Location string
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBotsIdUsersUserId(ctx.Request().Context(), request.(DeleteBotsIdUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBotsIdUsersUserId")
+	}
 
-type GetWidgetCallback203Response struct {
-	Headers GetWidgetCallback203ResponseHeaders
-}
+	response, err := handler(ctx, request)
 
-func (response GetWidgetCallback203Response) VisitGetWidgetCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(203)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBotsIdUsersUserIdResponseObject); ok {
+		return validResponse.VisitDeleteBotsIdUsersUserIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Sync Telegram bot by token
-	// (POST /bots)
-	PostBots(ctx context.Context, request PostBotsRequestObject) (PostBotsResponseObject, error)
-	// Login user by telegram mini app auth data
-	// (GET /miniapp/callback)
-	GetMiniappCallback(ctx context.Context, request GetMiniappCallbackRequestObject) (GetMiniappCallbackResponseObject, error)
-	// Login user by telegram widget auth data
-	// (GET /widget/callback)
-	GetWidgetCallback(ctx context.Context, request GetWidgetCallbackRequestObject) (GetWidgetCallbackResponseObject, error)
-}
-
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+// GetBotsIdUsersUserId operation middleware
+func (sh *strictHandler) GetBotsIdUsersUserId(ctx echo.Context, id int64, userId int64) error {
+	var request GetBotsIdUsersUserIdRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	request.Id = id
+	request.UserId = userId
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBotsIdUsersUserId(ctx.Request().Context(), request.(GetBotsIdUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBotsIdUsersUserId")
+	}
 
-// PostBots operation middleware
-func (sh *strictHandler) PostBots(ctx echo.Context) error {
-	var request PostBotsRequestObject
+	response, err := handler(ctx, request)
 
-	var body PostBotsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetBotsIdUsersUserIdResponseObject); ok {
+		return validResponse.VisitGetBotsIdUsersUserIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetLoginEvents operation middleware
+func (sh *strictHandler) GetLoginEvents(ctx echo.Context, params GetLoginEventsParams) error {
+	var request GetLoginEventsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostBots(ctx.Request().Context(), request.(PostBotsRequestObject))
+		return sh.ssi.GetLoginEvents(ctx.Request().Context(), request.(GetLoginEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostBots")
+		handler = middleware(handler, "GetLoginEvents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostBotsResponseObject); ok {
-		return validResponse.VisitPostBotsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetLoginEventsResponseObject); ok {
+		return validResponse.VisitGetLoginEventsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -650,6 +3854,88 @@ func (sh *strictHandler) GetMiniappCallback(ctx echo.Context, params GetMiniappC
 	return nil
 }
 
+// PostTelegramWebhookBotId operation middleware
+func (sh *strictHandler) PostTelegramWebhookBotId(ctx echo.Context, botId int64, params PostTelegramWebhookBotIdParams) error {
+	var request PostTelegramWebhookBotIdRequestObject
+
+	request.BotId = botId
+	request.Params = params
+
+	var body PostTelegramWebhookBotIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTelegramWebhookBotId(ctx.Request().Context(), request.(PostTelegramWebhookBotIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTelegramWebhookBotId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTelegramWebhookBotIdResponseObject); ok {
+		return validResponse.VisitPostTelegramWebhookBotIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteUsersUserId operation middleware
+func (sh *strictHandler) DeleteUsersUserId(ctx echo.Context, userId int64) error {
+	var request DeleteUsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersUserId(ctx.Request().Context(), request.(DeleteUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteUsersUserIdResponseObject); ok {
+		return validResponse.VisitDeleteUsersUserIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersUserIdExport operation middleware
+func (sh *strictHandler) GetUsersUserIdExport(ctx echo.Context, userId int64) error {
+	var request GetUsersUserIdExportRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersUserIdExport(ctx.Request().Context(), request.(GetUsersUserIdExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersUserIdExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersUserIdExportResponseObject); ok {
+		return validResponse.VisitGetUsersUserIdExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetWidgetCallback operation middleware
 func (sh *strictHandler) GetWidgetCallback(ctx echo.Context, params GetWidgetCallbackParams) error {
 	var request GetWidgetCallbackRequestObject
@@ -678,38 +3964,141 @@ func (sh *strictHandler) GetWidgetCallback(ctx echo.Context, params GetWidgetCal
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYeW/cNhb/Kg/cBWoDsmZ8FjtF/3CcZteAExup3WCRGAOO9CSxoUiFpDyeBgPs19iv",
-	"t59k8Ugdc8hH2xTYI3/NkHp85+8d5GeW6LLSCpWzbPKZ2aTAkvu/Z1plUiTuJToupN+qjK7QOIF+lSF3",
-	"tUH6m6JNjKic0IpN2HWB0HwEV3AHCa8tpuAKhKThyiKG97ysJLIJM5gKg4mb1kawiLlFRbvWGaFytoyY",
-	"nv2MiRsWRMSgMwg0Qd6cW1DaQaZrla5JSqRA5YZkhI1NCT8Yow2k3gVBVCroeykUd9oQb1WXbPKedYbd",
-	"bjFfRszgp1oYTInSf+2M6smbjWUUpL5FW2llcdvxaR+RdW1P01TQXy4blS3wma6d9zwSUxaxdQN65os3",
-	"vCQ1vDbLiKlaSj4jrzlTY8S0wsuMTd5/Zn82mLEJ+9Oox86oAc7o0hvxRrtX5PsWO8vo8VObWHuKPkg5",
-	"V3dcil7I7TJiJVrL84FInsLMCMyCG6AlW8VGww4oVmgdpNxx9lQwE50Sl5bfUDQHld3OJoEyfSCX6FOT",
-	"SVo5LpQF0SjbKNlb4fRHVF8iiQr+iJSZHkwig9wS0yEZ4RvMi4WHYzBKdDIGQ3GNEnPDS5hpB94yyLQp",
-	"OQnfxOcXz+jgjGmr36/M66iJ6MOI2EySLUiIB/AgUlROZAINxYyc+bzit39wiEfHJ8/x3X9DxW3io7Sb",
-	"BrG/u/ISvVCZHqgeV+eEPCi54rlQOQSzLHCVQm3REI59KDrIXp6/PIMro+9EiqS2E847ZJgATq/OWcTu",
-	"0NggcRzvx2MfigoVrwSbsMN4HI9ZxCruCg+Q0UyHtl1p64NF6OGk8nnKJuxKW/eCKIIXkFbpguiojFBU",
-	"Jp8ZryopEn9o9HOTvKHMbgMy1JZtUGxl6Sbsjo5PJqcvzvZe/vCKVnlx/lHu/bK4P/723cHdfk3AnO/v",
-	"Ux0V6gJV7go2Ofz2yXB6UcNh7OkI4X4jtFNvyMF4/DvcEPKyKUQTJpQ7OeoBLpTDHA2FTnLrpnahEkyn",
-	"fCibRInW8bJq85gOgK2TBK3Nagl0FubCFR2sqK60glPucM+JEoeyq67o80AFOVcpGYoW5gW6Ao2XTKGj",
-	"JG6OwQ65bRe08Wm9k3FpcbeXM9NaIldbIRHpA/FY1+GFdhD8smKtXLCIFchTNN7NFzrEY9uEV7WUcPP2",
-	"onXbGgANWl2bBGO2iYJoJaI9PAvnKjsZjZqdONGlz6vR/sHhqrcHZ1Nv28F4/49H0293dGLQh/R/wNNH",
-	"z8rbhn/wbujeL7S7bouXrcuSm8WjYwaVYi5rDNLS3snTflxZGcOb4a0bvtr26eekZcTC7O2Xa3NVGIK+",
-	"8ce+YaH9rOn7mktyS8jjXvFu+w9WvZ/oWNlpsjplP27RBFZOkXU9Kh6b7tevPwOoHprUYQfjPI46RbYd",
-	"s0uaH/9qBNUK7ytM3GYMbrp9oCQ1dOtq71ibAVjhseq8fru5lugkqY354t5qtLNo7tA0SnoBnTE/UqNZ",
-	"c9ls0cHJ8dxSzamMuOMO2S2dHZVCCV5Vo4RLOePJR9Izx4Ex5K/oXgfas5aUZhjDS3S+AL3frDo3Snyq",
-	"EaTOheqCvDL1CmtrTEnFy7d/h78tUsOpBgk6+6lGQ+VNhdus5zFNCi4lKn/he7hSbU0bm3qdaSkxoQXV",
-	"w94EMOhqo4JKa2WSDIfTqorhiluLKXDrbzB71i0kgld2ldEO3ldSp/i9b7+RnzkbME4+KIA9EGn4zYSx",
-	"bkpWhrWfNfolzaT9qiq009PayLDktSum1OfDsuC2+KA+KBruG4yUtXVe4H4MP6ERWbDLilyFd50dOrQL",
-	"PEm0SWkgdrqz+l//+KcFLnNthCvKmNgcxPATpSV32EuHzKAtFFrraQ5jOE0SrBxNHQaby8UmDO4EX4n6",
-	"hzBqep+1AR2CgWtUmxJqp7yqpq0W/FFI8O5F5Wqlaa9dmvprnY8pm/hGxrbRc2PRAM9Rubabdncjr3Jo",
-	"yL3ORL93mgeKoX76Wv8ipOSj43gMO++ESvXcwptr2B/H4+/gnVAnR9/B/cnR7tArxqZ2l1XzciS5ymue",
-	"I1QGMzSoEmxrK6oITB1BZnYfUjpEcO+iYfKA5qj2bn6MUH336ftx/JcB9W63BvbD7eHkwgMjk3oOldE0",
-	"22Aaw3XnWI9iyLQkCvI3DTJVuHCl7X2tnX0gWBI/dzJ627xZeqY5Kqp4mzVpS5f2odNLphSltHGFsJ6L",
-	"0/59R6gaPYG/IJJ5MZw7KPmiff4BFH5wbya61QKim/q+sheSpA/Dk3PWWmcITva6UnFrCxvlEfCq8tnc",
-	"PZW1jaKeSZE0fWIu0hzds9rEO0/6/9clgo+D9V87xX9Ipwi4/donvvaJr33it/eJkEVPdIllt7npvStP",
-	"A+3z68zoOaF9xqlCNoluA+yCG1CllRbK2d0ea42gbSxfhQtNx767x9UWQSu5Uhbau8/ydvnvAAAA//9D",
-	"zvnONB0AAA==",
+	"H4sIAAAAAAAC/+x9aXMbObLgX0HUvoixYooUJR8zrY4XsfLR03pjt70+nne37SXBKpBEuwhUAyhJnA5F",
+	"7N/Yv7e/5EVmAnXzkmW5260vCrGqcCUSeSPztyjRy1wroZyNTn6LjLC5Vlbgjx+0mco0FQp+JFo5oRz8",
+	"y/M8kwl3UqvDX6zG1zZZiCWH//7NiFl0Ev23w6rnQ3prD58Zo81rP0Z0dXUVR6mwiZE5dBadRKfpUiqW",
+	"GJEK5STPLMt48om5hWBG/FpII1JmE52L6CqO3ileuIU28l8i/ZpT5EawpbRWqjnThkl1zjOZRtDU9wqD",
+	"PtbuNEmEta90JpMVPGp2/LrIhGWcvRWZmBu+ZIUVhi0L65jlTtrZijnNrJwrJhVzC6OL+QJBM9VuyJ4K",
+	"JUVKjWRKs0oWIvkkUjaTxrrvmVjmbsUyaZ1lcplrK5jSzAjrjExgFkP2ZMEdW4rlVBi7kDl1U4K+NShL",
+	"FtzZIXu7EGymzVIYNgnfjuHdWKYTdsEtW+pzkTKpnG62ZRfSUXdzo4ucKb4UbAKvPhSj0f3Ed4I/xOSE",
+	"cVrfxUIzCbCimTI9Y1ytmHYLYcrO2ZKvmNIXJchgLF04NhWwU/XG0sWMq5TNDVfOA9GyuXA4tbXz8ZOW",
+	"qlqBZUnG5XLIXgtcS7W0cqXV4oVKcy2VY3LGlBCpSIcfVBRHudG5ME7SMeRZpi9EOs64mhd8LmwXdc6e",
+	"vf2Bhfcs0amwsCwYrcSmJJNCOeZ7q6HSkJ2y3MglN6uqj3tiOB/G7EMk1IfogPHMarbkLlkIy6SzzIi5",
+	"1Ipn7JwbyZWzNHPpxBLnJy75Ms9EdBIJeO5WOfwPiKbmcHaX/PKMvn30oHzNjeEreBuWDPswlmnPipuH",
+	"BPG9Z2HPEOHxjWXiXJiVVmJYnyegLXfRSSSVe/SgmqlUTsyFaU71aDQajbqzTfHk7TVZBZPpmfJNT82f",
+	"xnFuxFIWS5rajBeZi05mPLOiTd1eqmxV4cwraubPA5ynap5+qKnWmeCqPhh8Dci/42jUOR4PzvJimsmE",
+	"hS62jHlVPtLTX0TiYBYtOluScjpJL2fRyc+bOUCrg+gq/q11IhMjuBPpmLvGPqXciYGTS9GH8EWebmuj",
+	"iizjUzg0zhSi00cF4TQ6+bl7RrqI2LMlXZSIewhMXF/ixw6QPxKYHxspZnX4tqCEBGcs0+6BeHn29Ekg",
+	"SGdPGbdWJxLGa1DJPjDW+O7YOu4Ku42hP9buSdXoDbW5iiOZNjZi/SELqNyZy87jV4PWj8bm7ZVp5Ieu",
+	"tSrH7AXEx/7TACx9A00i7qQN8CSlRMamulBIk0rR4v1CqPCLLbgl9hUzXTu+C01yEIkOMeMgJknrDHfa",
+	"WOzfCP//jHHHMsGtY1oJz6mW9bP+fU0GgfdIu4mrS8uUdqVMQtIHTAwlpalgcISQpApBLco5FbbgWbZi",
+	"HEQHfNWYZR/z9Qy/wdIGR6PR0fH9Bw8f/e3v343iXXAIgdzdhH9UQo9YSudQRuoIE+xiIRpPUQIGQHh5",
+	"ZchOm9AGWaYEN/JukGU+RCTEYC/4rzhpAOBDBPvU95nv7ENEMKq4u3V8NouQEz0Xau4WxNCXUoWfR3GU",
+	"c+eEgQX/n59PB/+bD/41Gnw3Hg4+/vXfom1kLmxAgOEGJH8ureuSoZKflv9soxZwXprM9VGbs7YPK363",
+	"bmqwhy94nsPyOijwpLBOL5lMx05/EsrveMCGmTbh4P3FeoppK3zoIgKMI1LqxTJ9LoyRqWDWcZVyU74o",
+	"yawF3AMEBIl1Lq0TIOf/x/u34UtApQ5CklJghTnvF1nxq6YY6Dy9ob3ciC5dQgvL7O9u/750YRLR3YfH",
+	"2pF4NpMioyXTWs95ViCMHYf9mRkNgwoF0tTPrWWhnjX2pDrj1f+zIsvGXWqeL7TT48Jk+Dlx3zGI71Ec",
+	"SVuy6I/xWkBuPjwEuNjvR7n4HRD1ps5SrcttYv9eZ6rD0Tsb+n4hUB0sGR1PEpE7kZacjA6cpqMEu0X6",
+	"8pA913OpLPCQqQ466qTOcL2KP/EHASYGWqNyMit7l5bZlUqCTMOZEhc0pKehHoOwpxZD9/1HHzsbjKuH",
+	"zVkvd+29SU057qqrRShx6cZJYaw2fQQMngd1Ez5lOZ+LITudWqFcA774YivWbtz5DfImV+NftFRjIlXr",
+	"MaJUwrlCeSFNSQfz7fpUm5uQZasjfHR8X4D0MBB//246ODpO7w/4g4ePBg+OHz06enD0twej0Wib8OtN",
+	"O+Ne2U4ug1RFcPesBZrEjNO+lEyEXl7woJX6nqN4R83m5kTyxir6CHTtCRLi8nDH3raFi1LaMSt61YfP",
+	"F/orkl3viEj4FgUujuxCGzfeukz87PMXew39hJqA7U7N91RwfVMjuLe3dr8o8lwbB+Qtk0qMfy2EkWKH",
+	"gxoaMmrIQsO+k9q/M111/HMVMeq3Pu++7Y07VGk9GK6l3K3jfs/lTCSrJEPRzxWlORD1uYq/TVJpAWPT",
+	"CbG6Bj/jlpFFIWZZkyMWKgVb75LDiVFcJWLSaerEMteGGwk6ouLnXOLZaDI/njh5DuAM80B5rux1HQOk",
+	"Vb8WvxaiT0ypcLBlYcfnARSE5DGctgsFSys1WSvCguuLapDwN8lCpEUmUpZyx6fcggtgbrjf9ZpM+vDo",
+	"eLtQuu9Jbct5O+DIBsbZOO1bWIlHpwC8NjPxr+vcBDvfmZvsTKE3EZprGGbIPNdjWlcpOJIEal0lVaot",
+	"0y+Q3QOafwAKNM+M4OkKyDK7h3bPg37bZYfMlAQgTGfNjr6zwtywEAhd/lFkwMZcT27COltT3PoQSvbY",
+	"bs5egeRohLWN84GEoxexbd0K32VcQf/b5t1xfM6MAA4iUjatjPV9Y6IKijPaCxqV5rqvBHQds3cvbx7z",
+	"uXflNmEBW8/w3W5Q97bwbT6Zs6fRTna83cWGMHDLKCDzqL0tW4ztcfREq1kmE/dUOC4z28X4meCuMD2Y",
+	"g45Zeskc2DsTXlivASe+1wZbMyKVRiRuXBjZB04/qd6B4GPYFPqGxkM+oB2bgU25MRJpTH1j0IP2COiX",
+	"ZymCgIZKJbxfSsWdNjWZolzYx21EBt+Wi1oDeiuU+4fhym3xcHT1Inq79hx5m92YF6kUimxSJbVeA5WK",
+	"HofWaOKx+7VdcJVmex5KI8iMvllaTwheYPwIDbwV0xZTC/KaItO9sK6km21UaLgUqZPxrI/tvBGJVqnd",
+	"PHLMRmwpuLLwA0SSXU562whdbnQH8D37WANWH041I0w6SJVWp7wdeJJKR053/w3jU11QjIKATkmOrh2K",
+	"qvPVT4iGNJurrqqqldjBNfoSF/GTdj/AeQ706Cre3KpNv67iXUY5IxtY2QicjkthbS+LPGVTMGIRGFj4",
+	"rE5vfHcB+VBs3+58IFNs6K9vN1GXenYu+ujDVAfisANr2UJMriHUIDDInNy1Wp0WbnHs4QUIxB0obI7N",
+	"uMy87aoBP44u8TEphJ8luJMw1Wm/FG6hexj1j/qC+DwAGhmKnyq4OyZT7SYUCkTvgfhLs6wcaWjo9WFL",
+	"nKVC5CyT6lPMJsSAC5P5DkqJgHp69/o5mxbOacWktQWFDNGrhVQuZhP7SeaNweFBTlLZy9f/i/24Sg1H",
+	"4scVg1A1oRzqEimzwlqpVTkL6LHRlbcZLzA6yNqCeuXV3ChgCUKTskyouWhCITytNPKpAPJXfkHQ9gqM",
+	"tkIN2Tv1SYEm3OqClGByuSbalEFKgd1eyHSO9idwJI55nkdxRAbPEsBRHAFoykewqiiOyiF61XxduEQv",
+	"e1B3QvCYeKpeg0mAVHOfKuSRtlwD43MuVaW3ekCDNAhoFcAWBqn6CPQDIFcCV6pqw2M2oTNUb9s6XcGp",
+	"RsFs3AYbRDkKKElMKusEb4HbFngQ0UEEi0VuUxooqP9eeDal6usLyl2dH194tzyh0AoRYl8uS55BIgPV",
+	"/m8VjysKfJMqcdXr71ob7uWVXQUBfJpr1APv7gTlQCvHpbIhgjXwyJrvEVwFN6EXLPiGUdaE/qyz6r3F",
+	"4GB4xy4WK+bKRclyjF5JoETsyhfoMXYHS/7nKikEjA2Ovo2qSux3dD1GtGW07mlYgw8SDc8zKUoE3k2f",
+	"8z6tXWD3R1Ai/f4o7cY07A0okwHhnvAsm/Lk0/8ohFn1KABwHvpINIYebCFbYQywk1RSWY+wVQrSu/T2",
+	"wn/eS7FxWpvWe4Z+jn+K1VRzkz5GmarPfUtQGa9dvxOXbrvhBb+KW93tPr0X3Hwq8u70vLPmk/9sf4ay",
+	"ERo9LGZzcERrNpvW96La625s2zrCcA3e3TeH66Csx83dNafd8KLWbUxL3wS0d2i37DE5gBnDdYLr6fNA",
+	"tdDGNiVWlBt9LlNhht0gqYChvwY6sAuUmsTjM45ysM2Or7fhVeONYLTC9Ikjm63tO2uSO5rUSx14T0v3",
+	"nk7i2rI2weS9mC60/vRa5Nmq3/9/+uosKGjiUiRFy9IPAuRULHg2a3h1n1VBAfCMNoilWjQCZhlnBkbe",
+	"Euy6A/Sb57S5Co9lzGkmUuli9IWBJgy//Mu34tINdzO7V7aBUg0SKn1RmXmavfbqP7jq8bIk7/vTas8a",
+	"NlKczq7DCXjKHX9muPU2+ra9LxOg3YCxCD3QXWj+VISLSv5jFFnhYyAvM5kJuxscw1ikhovzcO1v23D4",
+	"PfPf76/YdZe4ZiofNwHwMtemR2p8BqHhbgH2Geu0Eam3irYv0vHEaIvXhAB6toP8G+D/ygM5DJDTLbOY",
+	"SZVkRQpDw4mreQRBwS82u6p2Ehqai/euzz5pwZu/x2iM7lmCd2RYNpfnAqMdanFrtmHA2HVuDd9Iz5Q2",
+	"Ixnq18EuUroCEGbapMJ4DkqmzRvQ2Gv2jb1ZXenKqyNwY3mdDdiOyWEz97mX1HLSf4a9ubVE37Lvqs9V",
+	"HFmRFEa6FQS9LGkovDdwmst/ih4eBmEVMkE29kmswt7mRp4DR4LHObeWwoQmp/4OL4bOnLDHghthGF1B",
+	"+CRW/qrlkP1TrPylVjDxzgtD7d/8eDo4fvgILAoLYZnTc/JGheBLaShK3l9QRGCimIDjVJBZOIekHRf2",
+	"Rs7VGpeqDzrCiypVbK9dcINm3cQIR1dSgqEQr6Uk3BiSByf/c4CXNQb/FKvBWTqJqycQa2MdX+YTdu+d",
+	"kpfMkn/rgEy94bOfNMZb3SuU/LUQSIz8WDErcjjaR8d/B2grh1dhTt88OTsDe67hiRPGHrCF4Cld0qn3",
+	"Wy56gtzaabYQl/d+fHH6ZPDmx9Pjh4/u0fJi9uLZ2x9fPmV/hasiH9SHiP01zAD8xo3nLqyp8VTBEhpP",
+	"YCi/lfemOl0dHBwcwMVU+lLWbK0o4BiBMvbFQmYC76NW40hbRnrTlkvYNlpyiOQ7iTqLrjCBE1bj1W+p",
+	"ZrrH2/TqDIWZJVd8DtQ/kNFA9m3wPpQsCKntK68LwFjSoQWj/wM4I1EcnQtjacTR8Gg4QttJLhTPZXQS",
+	"3R+OhqMIL9cs8EgeTrU7DEoFPJiLHnb5SmdZpZ3UrM185rwTl4hwLlQtVr50nMA9EVcYZdnx6LgW8u6l",
+	"EWGtsOyN48bVPDBohFcMiBpw/T57+rnkFQuijQPShlThLIW7UgJIZlB/cOGGL4VDjv1zJ06EDkdzjJpt",
+	"q/KpVIMGXCF9rEQVIvSVs6JOOcnCVWUW6AiEHb+bIn+dR2zwEqdp+2p8Bew1U8LGGyeyMeCwO61uWE1p",
+	"Wus9PvD94HROX1SjVpa5F/pfMsv44cPhiN17L1UKN7B/esuORsPR9+y9VI8efM8uHz04iHaY3cvc+73L",
+	"IKjciJkwQiXlTXWhYmaKmM3MwbpJnyJZGDz3nayZuVCDd29iob7/9d9Hw+96pvcxbqbJOB4d99sy8Ugs",
+	"vPGyckzW/FGVi3IV2AbBHRxCRZayXGcZuaqGcPqPR/fXSVKzTF+ANpDACUwbfSEHmmm45YvjgafMmyVK",
+	"N+lzTdkyPG8AxKP/cInhbR87pIAh7HQuFJzY9rnqzCVEGVWEA5FfWuzFaQCWk6qg9AlIGWF5Q3ZGSR28",
+	"o4IJiazeu8RYRRCYNt61XT2r8X/eilzrCXW6QvJvi+WSmxXKz0S61rmZ684E6I3PgSpFdJ09QinqENWO",
+	"ii73kTe7ja4R8aWbt9AfkolG9CkgW3hIwacAUa7qAv86SofNoh6CVota3TojCqAub/Bb4AW1G4dwdOUl",
+	"u5dwKwZSWaGshCDwgzWTCh2NqWH0GVTOO+YMztiLnzVP3qSy2YlzqQuLjHHNtKhJtIX490N5KZtks0yS",
+	"8HCEoeNyCUaOo9GInOr0q0eG71Ki0Y3lwWlfM+vJhPMKKLGe0a7XlLazp0CrHtzgZLYm5Qk+PdoVOAYE",
+	"ZZzH0bruS+AdNlIJYaP72xtV+ZGu4ujh7S7XwaHIGF7B9bQuqqtqSDoaShoqefbECJ5GH69i/7am6TQ/",
+	"+FinfoAJzFT3g70BpaRypNlBt1GubQ91e6VtIG9eGHus09VeAGu7Y6RD1874XBg5k+v4E0QPvZBKstM8",
+	"Z9AI3c0k5EgbLmpSHxj5AXrkBKgXCak19QdVTJYKIyGXEd7IatwkhYigSoXCHgJX+Itlz9Ljhw+PvoNh",
+	"TTrIuXErVn6OikNpqVXCSwl0W5Q9FzPHChUuGqACpOmCeDM2BCYYxVHZbfPisO2qOjXfGgy1IfijXGXb",
+	"6fvg4aOT08dPBk+f/QC/5ouzT9ngX6vLh397f3x+VIBb+OLoKGpcgbn/t63OVByqa45ofgc87+ozaeC1",
+	"nF/ecUD3e9femyF9tHF5hiSVWZHh3WBiirUA/r1Syex6V2WqyYXum7VEhX0uqPTvR8/9RYRLbbXZald5",
+	"8ociy1AKbHvYpujCoAvsw42qT4WeYNOxJ4eH/skw0UsUwg6Pju9H8XYJEATuoy+PTdcHtI+M+hYgvZu4",
+	"4Pv3LAB5/mPt3gbiVXGs9UE+YFeBlA40WloBeVwFC9VisH3oVBn6RN/6KKUQZEs/G1FN2I79BZv9JSJb",
+	"UmO+L3gGYBFpc+Ll4y889SqeKlqWM6m7kzev6ITVWlWZDm9MkKuHaQcVX67d04NbFPQeHH+3J6I6rV9w",
+	"tfKWY9vc79fcCRJWmbhMMAlgfZur7XBag8FxVV2fyIVhMo+ZEc6svP3u/sje6H68DaMivyqHLqUfr9Zr",
+	"U09V0yRBr2F6g1OY3oYrHJpdcOlCmDIuCYjDTuTn/qiHqF7tLJTX96pQ4jKnaNrGNr0rnzMZhO9w56J9",
+	"Jmt91M9T9ZhaMp0khTE3foCurRpcGElC/FrdIHzRUA7eAGY0zuR0RceyV0UIlpDD32R6VTnAu6jxVFS2",
+	"YjrpddeOdNab2jHZFabqWVKiGZ+R1BtB6vk5pGWfRO4a7s4+WzMNDQrLGQVYNwTMB+uSU0Cj9DZJ0ejB",
+	"Duh9Q4gFS6yiMP9AGu810ZpwoG3Pq2m6m8x4fWhzo7aZLTt1h4TfgtnlH8JtwL+WnRhNjeAMrCyNMt3I",
+	"P7crJh8bxPqQ7oAN8loC637S/VpAwmcKtgkuuDLJdCMXyUbS20jDugsZPvWOAGzxrRPk5mL/hKSZNwBQ",
+	"IdW16PVmXLtR2t2bnnjrBtfW9w0Td20Y/+Pj9bWp/Z4ofRssII7yos+iX6w7ONcz7++VkfvmzcBf5Mha",
+	"fh4O3lfwhTVxydtQSqtNs1zAwZ3A+G2wxjf7EpGmhIeJvbfFKJylT/CzL3vkyozOPXDF8f8kHPHPwwDR",
+	"y1zVK2nv8e+ABbaIvapV1GHG6zw+tWC9ik64e7MUqkfjKTlpdaq+CAttHqhbZZ/bz7IRecaTr8gtccs8",
+	"k0wLGs5vpDa16kR3nPIb4ZSvCeP2JDgtdglm50EwO+/AN2vp0L84/2xnc+87ew2z+R1D/RYZKtWIcJol",
+	"azf796tcdg/Ml2GNvWfldlnk/sf16/PM+nwC8wx1QfxbjAHWpsZTCSFLr90dQ/3GGOo1CU6bs8oy/2vw",
+	"Max3FDyhr3f11PpcVd5BjNHxqxAZf4eM3wQyvlOwuxg3gLEqzcsPvzdFMtQMqYId6F6pYuJSWgfUtUoo",
+	"6FM30mLgjiT9F8qZ1LCaWanmGXUI9zP6Qh1qrLY8QzcRoL2pYkp9AVAzpRZZ3rqIvnfNlC03QtZkdb39",
+	"QOPN2T53DkPeMxQYz8TmpPWIcNXdoR0T128DZZcYVWhau234tQSZRlLYb9u75k9dgxc8GH13e/N4UoZi",
+	"BZSr8+GyhPPvhUfBLI5vbxYVmZe2XjaFJvLga0wkHA4nwW2kC/dFGffzwLabKUp2Fhkp1eHt8HJ/1amF",
+	"3xiTb/HGZYPR1Vkbqj+A95ZJV6u1Ch+VbLtk1M2bwZiEgVIi+HuMePcSL6LiU9rUXmavbYPb25tm91XZ",
+	"58YlrGLJ1QAOO6BySYKw6uRTuvto60DANw3u/2IFF7j6LsNgvpUxPLZ9IaRV5xNeTzSCKcEmlADDiJkR",
+	"dkEFOCeN8uBlnaJOW4RcrR20WgK88G6nB0etjlNvTqpuue6q/EKzjPrP5RULnufD+jWLMuPDx77a4Wvq",
+	"OCylCrVNu7OoSgpsgKbOhZKph6DPQjVpbNrPEX1DmZbgfRRHejbDzIXko2zMeUuNpPYsEerjUEJ/DDs0",
+	"XpdDvDFzvzd0jsZTbmUyGbJ3VrCJ0kpMMJLd1yGnb+2wXl6i2zyKW0+RMsSBUkGaxvEvF/AEBtieSrSJ",
+	"BtcTTo++mHC6ra5FAxQ99LFOxkI2kUDEtEqqolL9+7CNAuxeBqNPNwEpaddiMJ3TuvvQ16nasRnhd8hP",
+	"WK9iUd/E9kqaIC0nu3EGu4j9fufDpbXAAkW92Ph1b69hPU/qH/q80Qtsh6UIcmP32G7cEEuQ9ZmxCGZ/",
+	"FkvWrWovj/U61aWmw97pLX8+vYXE/sZtI+Bfu1m7q4KFX9EK+ZRKcFY2SGS/DjUUKvhZq8yJBkpd+FtP",
+	"YKCULmbgAWJpAeQQ9B+pEky5VVYcbdQIbehDndrZVBnU5z5i78qbXlY413InYLdwr2ujffNNqK34hXyI",
+	"zZqkt+9AbFX5XHc1H7/6ypGpfhKYigfvIN85Xb6Z4NNsfeHhHQhhmYB3cxwNkoN9UmXVS/uGhFIxw4Td",
+	"pYc64+FHWQgFJo63e3vSVYHnJRMcs/9O/juWX5JzpU0j7WQr9ZMV3CSLz0lh9dKklJqZVhTSODYSC/cM",
+	"jNmZ+nNO1atRwlg1Vbf7pvaE26RXk71LuvVZZLxT2ndz4i2PB/WUyU18+PNl4roj8VtIPKLMxoiyxhfd",
+	"kDJ8HTIgS1XW1/v68WQ93OTwN5+/e+dkAyHLOxkt66nJhzWJNQkZ1bkq6/nZUhSNG5XRfI9Uc86X/8K6",
+	"YHhGt2UgQG4Hf/ZIRoAj/wkyEuA6/5gHcKOQ1fxkTWICXP21brtuRKkb52e7bOIdkn5DXMInLtiEn7fA",
+	"DeLebqtqDp/NaZB6D6oKF70J10O69LLyaNasfFGmUsq4E9YxrcSwL/l5Vd6iR/PpE3l9TYe9Yba1Tv66",
+	"LL3XGq2vs7qjYg9Nqa+rqopo1dFnllLdnAKZsKFyctCV9jK3fiho0jNVK1XSnOgu6SD3mo3PrrVlImiL",
+	"u4GJ3Gl+uxPxNUVsNyh/9ZpMfUTkq2l+M5k5YeK7XMxfVAdrleTqN6wBOvM876sK0uEvL+jbP3qBjdf8",
+	"gk0C0xq+F9PTPB9KJR1UXpowagikqKyA4KlQaFOmi47BvzwQKtEpEa8yMAvnX5UWwLoEwWYYU5k7ciOf",
+	"fFCMDej7sUzpFzLSe//x5uVPAyuM5BkgOqOZgrR8QJ+hdx3oLf2E3M4f1AcFkWAe05aFdTjC0ZD9pzBy",
+	"Rgupskrfg0YHrLChQtmHiIYBWHyIsDgT5rOmKDPo6njI/hNOMXeimgHDOCclrMVv7g8ZldIgC/4voZLD",
+	"1oIufZtfJvK+K2fyeylncldj5OvUGCEg41yBJgV6BESc8TzH8xiqpfcWGAlNDi+oxOjhb6QDoOWrP1rV",
+	"FyNlIainfhcDCRlPFlRe9FTZC1j25BArevgKbViJyNdoYz7XqQ1V0aZY3JiKeAPSknmtxovZVABpqlkS",
+	"NRUl8/VVKl800RaUaasKp/WSUxJjyfJMijKUlHa9Zpv0YNkaItuq1PpYOzSTbFdZS5XrM9XWlsQnzGCq",
+	"Q8icL1VvhasgERYGVzuqDRyuL34WVjh4rN3gNJeDN9j34K0PZd2dAX/8Mg71Vh3mW3an95bq7ZH9aHYV",
+	"Sfx6HvUaanwVE5cMRYYD8kVN2vZaJALiORp5gun2kl1HzvYw378W5/pTSL5ChnmKL0SyUVrn61VGO+H4",
+	"qXcBiDUFZcuWrUqyMd1cAepI6cptQWXABZX8LbNmD9kb4CIitXRylaZRpO0faL1f4JbMt+3axX0nwIZV",
+	"w2q/4gkI5qk/l5Z5F+W3e3Tf53ld4AyIsnhQq7j0bjbu3QyrX8xa3SKnh6IsqL3Rbn1j5NCnpSupYX+N",
+	"zxpp8xW/b4PA0Uib6Zv/5o6+3dG33zF9u55JkQ7AH5q8Xch0LtxOps73+Okf3dL5RGeZwIzqsGXVEiqH",
+	"S9uySQYGWv2QvSprsAOEB9atuqZNy+6JyzzTqfh3zBTQZ90Mdk2MpsQbRfQ7442fIeySfuUL7fS4MNlN",
+	"Gzt5kmiDQZlOlyv////3/wGDmmsj3WL55Q2dHmZhU/tQIdhqxoS34zCHzXZQnqaSjIevajf26KvW/as4",
+	"wh2NTvAARXdG0jsj6TdsJKVTtMVEelU+7JjY8BsWCvtPjb4AbJ+CSltVvUK0IzAEe6k9qHDND9RjwCOm",
+	"WXZfVpAqrECbZq0P+jS6+nj1XwMAFOAVd5y/AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do/v2"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+const botCredentialsJobName = "bot_credentials"
+
+// startBotCredentialsJob periodically re-verifies bot tokens with Telegram.
+func startBotCredentialsJob(ctx context.Context, injector do.Injector, logger zerolog.Logger, interval time.Duration) (<-chan struct{}, error) {
+	verifyBotCredentials, err := do.Invoke[*usecase.VerifyBotCredentials](injector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build bot credentials job: %w", err)
+	}
+
+	return startPeriodicJob(ctx, injector, logger, botCredentialsJobName, interval, func(ctx context.Context, logger zerolog.Logger) error {
+		output, err := verifyBotCredentials.Execute(ctx, &usecase.VerifyBotCredentialsInput{})
+		if err != nil {
+			return err
+		}
+		logger.Info().
+			Int("checked", output.Checked).
+			Int("invalid", output.Invalid).
+			Int("skipped", output.Skipped).
+			Msg("bot credentials verification completed")
+		return nil
+	})
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/keyring"
+)
+
+// runGenerateBotTokenKey prints a new data key wrapped by the master key file,
+// to be used as wrapped_key of an envelope bot token key.
+func runGenerateBotTokenKey(masterKeyPath string) error {
+	if masterKeyPath == "" {
+		return errors.New("master key file is required: generate-bot-token-key <master-key-file>")
+	}
+
+	masterKey, err := keyring.ReadMasterKey(masterKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read master key: %w", err)
+	}
+
+	wrappedKey, err := keyring.GenerateWrappedKey(masterKey)
+	if err != nil {
+		return fmt.Errorf("failed to generate wrapped key: %w", err)
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, wrappedKey)
+	return err
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do/v2"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// jobRunFunc runs a job once and logs its result. Errors are logged by the job loop.
+type jobRunFunc func(ctx context.Context, logger zerolog.Logger) error
+
+// startPeriodicJob runs the job on start and then every interval until the context is cancelled.
+// A non-positive interval disables the job. The returned channel is closed once the job has stopped.
+func startPeriodicJob(
+	ctx context.Context,
+	injector do.Injector,
+	logger zerolog.Logger,
+	name string,
+	interval time.Duration,
+	run jobRunFunc,
+) (<-chan struct{}, error) {
+	done := make(chan struct{})
+	if interval <= 0 {
+		close(done)
+		return done, nil
+	}
+
+	jobLock, err := do.Invoke[service.JobLock](injector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s job lock: %w", name, err)
+	}
+
+	logger = logger.With().Str("job", name).Logger()
+	ctx = logger.WithContext(ctx)
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		logger.Info().Dur("interval", interval).Msg("starting job")
+		for {
+			runLockedJob(ctx, jobLock, logger, name, interval, run)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done, nil
+}
+
+// runLockedJob runs the job once. The lock is held for the whole interval, so that every instance
+// started or ticking within it skips the run.
+func runLockedJob(
+	ctx context.Context,
+	jobLock service.JobLock,
+	logger zerolog.Logger,
+	name string,
+	interval time.Duration,
+	run jobRunFunc,
+) {
+	if err := jobLock.TryLock(ctx, name, interval); err != nil {
+		if errors.Is(err, service.ErrJobLocked) {
+			logger.Debug().Msg("job is run by another instance")
+		} else if ctx.Err() == nil {
+			logger.Error().Err(err).Msg("failed to lock job")
+		}
+		return
+	}
+
+	if err := run(ctx, logger); err != nil && ctx.Err() == nil {
+		logger.Error().Err(err).Msg("job failed")
+	}
+}
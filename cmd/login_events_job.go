@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do/v2"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+const loginEventsJobName = "login_events"
+
+// startLoginEventsJob periodically removes login events older than the retention period.
+func startLoginEventsJob(ctx context.Context, injector do.Injector, logger zerolog.Logger, interval time.Duration) (<-chan struct{}, error) {
+	pruneLoginEvents, err := do.Invoke[*usecase.PruneLoginEvents](injector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build login events job: %w", err)
+	}
+
+	return startPeriodicJob(ctx, injector, logger, loginEventsJobName, interval, func(ctx context.Context, logger zerolog.Logger) error {
+		output, err := pruneLoginEvents.Execute(ctx, &usecase.PruneLoginEventsInput{})
+		if err != nil {
+			return fmt.Errorf("deleted %d login events before failing: %w", output.Deleted, err)
+		}
+		logger.Info().Int64("deleted", output.Deleted).Msg("login events pruning completed")
+		return nil
+	})
+}
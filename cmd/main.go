@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	stdlog "log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -32,8 +33,17 @@ func main() {
 
 func run() error {
 	configPath := flag.String("config", "config.yaml", "path to config file")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-config path] [serve|reencrypt-bot-tokens|generate-bot-token-key <master-key-file>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
+	// Generating keys needs no config, so that it works before the config is written
+	if flag.Arg(0) == "generate-bot-token-key" {
+		return runGenerateBotTokenKey(flag.Arg(1))
+	}
+
 	cfg, err := config.Read(*configPath)
 	if err != nil {
 		return fmt.Errorf("failed to read config: %w", err)
@@ -51,12 +61,47 @@ func run() error {
 	stdlog.SetFlags(0)
 	stdlog.SetOutput(logger)
 
+	switch command := flag.Arg(0); command {
+	case "", "serve":
+	case "reencrypt-bot-tokens":
+		return runReencryptBotTokens(injector, logger)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+
 	app, err := do.Invoke[*echo.Echo](injector)
 	if err != nil {
 		return fmt.Errorf("failed to build echo app: %w", err)
 	}
 
-	serverErrCh := make(chan error, 1)
+	var adminApp *echo.Echo
+	if cfg.AdminServer != nil {
+		adminApp, err = do.InvokeNamed[*echo.Echo](injector, di.AdminEchoApp)
+		if err != nil {
+			return fmt.Errorf("failed to build admin echo app: %w", err)
+		}
+
+		listener, err := do.InvokeNamed[net.Listener](injector, di.AdminListener)
+		if err != nil {
+			return fmt.Errorf("failed to build admin listener: %w", err)
+		}
+		adminApp.Listener = listener
+	}
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	botCredentialsJobDone, err := startBotCredentialsJob(jobsCtx, injector, logger, cfg.Jobs.BotCredentials.Interval)
+	if err != nil {
+		return err
+	}
+
+	loginEventsJobDone, err := startLoginEventsJob(jobsCtx, injector, logger, cfg.Jobs.LoginEvents.Interval)
+	if err != nil {
+		return err
+	}
+
+	serverErrCh := make(chan error, 2)
 	go func() {
 		logger.Info().Str("address", cfg.HTTPServer.Address).Msg("starting http server")
 		if err := app.Start(cfg.HTTPServer.Address); err != nil {
@@ -66,6 +111,17 @@ func run() error {
 		serverErrCh <- nil
 	}()
 
+	if adminApp != nil {
+		go func() {
+			logger.Info().Str("address", adminApp.Listener.Addr().String()).Msg("starting admin http server")
+			if err := adminApp.Start(""); err != nil {
+				serverErrCh <- fmt.Errorf("admin: %w", err)
+				return
+			}
+			serverErrCh <- nil
+		}()
+	}
+
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	defer signal.Stop(sigCh)
@@ -85,10 +141,28 @@ func run() error {
 
 	var shutdownErrs []error
 
+	stopJobs()
+	select {
+	case <-botCredentialsJobDone:
+	case <-shutdownCtx.Done():
+		shutdownErrs = append(shutdownErrs, errors.New("bot credentials job did not stop in time"))
+	}
+	select {
+	case <-loginEventsJobDone:
+	case <-shutdownCtx.Done():
+		shutdownErrs = append(shutdownErrs, errors.New("login events job did not stop in time"))
+	}
+
 	if err := app.Shutdown(shutdownCtx); err != nil {
 		shutdownErrs = append(shutdownErrs, fmt.Errorf("echo shutdown failed: %w", err))
 	}
 
+	if adminApp != nil {
+		if err := adminApp.Shutdown(shutdownCtx); err != nil {
+			shutdownErrs = append(shutdownErrs, fmt.Errorf("admin echo shutdown failed: %w", err))
+		}
+	}
+
 	if db, err := do.Invoke[*gorm.DB](injector); err == nil {
 		sqlDB, dbErr := db.DB()
 		if dbErr != nil {
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/do/v2"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/postgres"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/keyring"
+	"gorm.io/gorm"
+)
+
+const reencryptBotTokensBatchSize = 100
+
+// runReencryptBotTokens re-encrypts all bot tokens with the active key of the keyring.
+// Run it after adding a new active key and before removing the old one from the config.
+func runReencryptBotTokens(injector do.Injector, logger zerolog.Logger) error {
+	tokenKeyring, err := do.Invoke[*keyring.Keyring](injector)
+	if err != nil {
+		return fmt.Errorf("failed to build token keyring: %w", err)
+	}
+
+	botRepo, err := do.Invoke[*postgres.GormBotRepository](injector)
+	if err != nil {
+		return fmt.Errorf("failed to build bot repository: %w", err)
+	}
+
+	defer func() {
+		if db, err := do.Invoke[*gorm.DB](injector); err == nil {
+			if sqlDB, err := db.DB(); err == nil {
+				_ = sqlDB.Close()
+			}
+		}
+	}()
+
+	ctx := logger.WithContext(context.Background())
+	logger.Info().Str("active_key_id", tokenKeyring.ActiveKeyId()).Msg("re-encrypting bot tokens")
+
+	count, err := botRepo.ReencryptTokens(ctx, reencryptBotTokensBatchSize)
+	if err != nil {
+		logger.Error().Err(err).Int("reencrypted", count).Msg("bot token re-encryption failed")
+		return fmt.Errorf("failed to re-encrypt bot tokens: %w", err)
+	}
+
+	logger.Info().Int("reencrypted", count).Msg("bot token re-encryption completed")
+	return nil
+}
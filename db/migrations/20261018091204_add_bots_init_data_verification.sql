@@ -0,0 +1,6 @@
+-- migrate:up
+ALTER TABLE bots
+    ADD COLUMN IF NOT EXISTS init_data_verification VARCHAR(16) NOT NULL DEFAULT 'hash';
+
+-- migrate:down
+ALTER TABLE bots DROP COLUMN IF EXISTS init_data_verification;
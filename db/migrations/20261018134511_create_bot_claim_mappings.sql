@@ -0,0 +1,16 @@
+-- migrate:up
+CREATE TABLE
+    IF NOT EXISTS bot_claim_mappings (
+        bot_id BIGINT NOT NULL,
+        scope VARCHAR(64) NOT NULL,
+        claim VARCHAR(64) NOT NULL,
+        source VARCHAR(32) NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        -- Primary key
+        PRIMARY KEY (bot_id, scope, claim),
+        -- Foreign key
+        CONSTRAINT fk_bot_claim_mappings_bot_id FOREIGN KEY (bot_id) REFERENCES bots (id) ON DELETE CASCADE
+    );
+
+-- migrate:down
+DROP TABLE IF EXISTS bot_claim_mappings;
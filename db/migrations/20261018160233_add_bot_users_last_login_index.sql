@@ -0,0 +1,6 @@
+-- migrate:up
+-- Create index for listing bot users ordered by last login
+CREATE INDEX IF NOT EXISTS idx_bot_users_bot_id_last_login_at ON bot_users (bot_id, last_login_at DESC, user_id DESC);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_bot_users_bot_id_last_login_at;
@@ -0,0 +1,6 @@
+-- migrate:up
+-- Create index for looking up a user across all bots
+CREATE INDEX IF NOT EXISTS idx_bot_users_user_id ON bot_users (user_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_bot_users_user_id;
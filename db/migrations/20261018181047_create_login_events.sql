@@ -0,0 +1,23 @@
+-- migrate:up
+CREATE TABLE
+    IF NOT EXISTS login_events (
+        id BIGSERIAL PRIMARY KEY,
+        method VARCHAR(32) NOT NULL,
+        outcome VARCHAR(16) NOT NULL,
+        -- Not referenced, events outlive deleted bots
+        client_id VARCHAR(255) NULL,
+        bot_id BIGINT NULL,
+        user_id BIGINT NULL,
+        error_code VARCHAR(64) NULL,
+        ip INET NULL,
+        user_agent TEXT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    );
+
+-- Create indexes for listing events of a bot or a user from the latest one
+CREATE INDEX IF NOT EXISTS idx_login_events_bot_id_id ON login_events (bot_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_login_events_user_id_id ON login_events (user_id, id DESC);
+
+-- migrate:down
+DROP TABLE IF EXISTS login_events;
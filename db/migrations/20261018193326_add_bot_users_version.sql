@@ -0,0 +1,7 @@
+-- migrate:up
+-- Version counter for optimistic concurrency control of bot user updates
+ALTER TABLE bot_users
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- migrate:down
+ALTER TABLE bot_users DROP COLUMN IF EXISTS version;
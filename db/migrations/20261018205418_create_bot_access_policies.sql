@@ -0,0 +1,20 @@
+-- migrate:up
+CREATE TABLE
+    IF NOT EXISTS bot_access_policies (
+        bot_id BIGINT NOT NULL,
+        allowed_user_ids JSONB NOT NULL DEFAULT '[]',
+        denied_user_ids JSONB NOT NULL DEFAULT '[]',
+        require_username BOOLEAN NOT NULL DEFAULT FALSE,
+        require_premium BOOLEAN NOT NULL DEFAULT FALSE,
+        allowed_languages JSONB NOT NULL DEFAULT '[]',
+        required_chat_id BIGINT,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        updated_at TIMESTAMP,
+        -- Primary key
+        PRIMARY KEY (bot_id),
+        -- Foreign key
+        CONSTRAINT fk_bot_access_policies_bot_id FOREIGN KEY (bot_id) REFERENCES bots (id) ON DELETE CASCADE
+    );
+
+-- migrate:down
+DROP TABLE IF EXISTS bot_access_policies;
@@ -0,0 +1,17 @@
+-- migrate:up
+CREATE TABLE
+    IF NOT EXISTS bot_chats (
+        bot_id BIGINT NOT NULL,
+        chat_id BIGINT NOT NULL,
+        group_name VARCHAR(64) NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        -- Primary key
+        PRIMARY KEY (bot_id, chat_id),
+        -- Unique constraint
+        CONSTRAINT bot_chats_bot_id_group_name_key UNIQUE (bot_id, group_name),
+        -- Foreign key
+        CONSTRAINT fk_bot_chats_bot_id FOREIGN KEY (bot_id) REFERENCES bots (id) ON DELETE CASCADE
+    );
+
+-- migrate:down
+DROP TABLE IF EXISTS bot_chats;
@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE bots
+    ADD COLUMN IF NOT EXISTS credentials_status VARCHAR(32) NOT NULL DEFAULT 'valid',
+    ADD COLUMN IF NOT EXISTS credentials_checked_at TIMESTAMP;
+
+-- migrate:down
+ALTER TABLE bots
+    DROP COLUMN IF EXISTS credentials_checked_at,
+    DROP COLUMN IF EXISTS credentials_status;
@@ -0,0 +1,11 @@
+-- migrate:up
+ALTER TABLE bots
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS status_reason VARCHAR(512),
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP;
+
+-- migrate:down
+ALTER TABLE bots
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS status;
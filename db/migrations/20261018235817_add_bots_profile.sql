@@ -0,0 +1,30 @@
+-- migrate:up
+ALTER TABLE bots
+    ADD COLUMN IF NOT EXISTS description VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS short_description VARCHAR(120) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS can_join_groups BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS supports_inline_queries BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS photo_file_unique_id VARCHAR(64);
+
+CREATE TABLE
+    IF NOT EXISTS bot_photos (
+        bot_id BIGINT NOT NULL,
+        file_unique_id VARCHAR(64) NOT NULL,
+        content BYTEA NOT NULL,
+        content_type VARCHAR(64) NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+        -- Primary key
+        PRIMARY KEY (bot_id),
+        -- Foreign key
+        CONSTRAINT fk_bot_photos_bot_id FOREIGN KEY (bot_id) REFERENCES bots (id) ON DELETE CASCADE
+    );
+
+-- migrate:down
+DROP TABLE IF EXISTS bot_photos;
+
+ALTER TABLE bots
+    DROP COLUMN IF EXISTS photo_file_unique_id,
+    DROP COLUMN IF EXISTS supports_inline_queries,
+    DROP COLUMN IF EXISTS can_join_groups,
+    DROP COLUMN IF EXISTS short_description,
+    DROP COLUMN IF EXISTS description;
@@ -0,0 +1,21 @@
+-- migrate:up
+-- The chat required by an access policy becomes a bot chat, so that chat memberships have a single source.
+-- A bot that also had chats now admits members of any of its chats, including the required one.
+INSERT INTO
+    bot_chats (bot_id, chat_id, group_name)
+SELECT
+    bot_id,
+    required_chat_id,
+    'chat' || required_chat_id
+FROM
+    bot_access_policies
+WHERE
+    required_chat_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE bot_access_policies
+    DROP COLUMN IF EXISTS required_chat_id;
+
+-- migrate:down
+ALTER TABLE bot_access_policies
+    ADD COLUMN IF NOT EXISTS required_chat_id BIGINT;
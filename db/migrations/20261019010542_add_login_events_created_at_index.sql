@@ -0,0 +1,6 @@
+-- migrate:up
+-- Create index for pruning events older than the retention period
+CREATE INDEX IF NOT EXISTS idx_login_events_created_at ON login_events (created_at);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_login_events_created_at;
@@ -0,0 +1,7 @@
+-- migrate:up
+-- Version counter for optimistic concurrency control of bot updates
+ALTER TABLE bots
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- migrate:down
+ALTER TABLE bots DROP COLUMN IF EXISTS version;
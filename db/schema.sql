@@ -19,6 +19,60 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: bot_access_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bot_access_policies (
+    bot_id bigint NOT NULL,
+    allowed_user_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    denied_user_ids jsonb DEFAULT '[]'::jsonb NOT NULL,
+    require_username boolean DEFAULT false NOT NULL,
+    require_premium boolean DEFAULT false NOT NULL,
+    allowed_languages jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone
+);
+
+
+--
+-- Name: bot_chats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bot_chats (
+    bot_id bigint NOT NULL,
+    chat_id bigint NOT NULL,
+    group_name character varying(64) NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: bot_claim_mappings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bot_claim_mappings (
+    bot_id bigint NOT NULL,
+    scope character varying(64) NOT NULL,
+    claim character varying(64) NOT NULL,
+    source character varying(32) NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: bot_photos; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bot_photos (
+    bot_id bigint NOT NULL,
+    file_unique_id character varying(64) NOT NULL,
+    content bytea NOT NULL,
+    content_type character varying(64) NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
 --
 -- Name: bot_users; Type: TABLE; Schema: public; Owner: -
 --
@@ -36,7 +90,8 @@ CREATE TABLE public.bot_users (
     language character varying(10),
     last_login_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone
+    updated_at timestamp without time zone,
+    version bigint DEFAULT 1 NOT NULL
 );
 
 
@@ -51,10 +106,59 @@ CREATE TABLE public.bots (
     username character varying(255) NOT NULL,
     token bytea NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp without time zone
+    updated_at timestamp without time zone,
+    init_data_verification character varying(16) DEFAULT 'hash'::character varying NOT NULL,
+    credentials_status character varying(32) DEFAULT 'valid'::character varying NOT NULL,
+    credentials_checked_at timestamp without time zone,
+    status character varying(16) DEFAULT 'active'::character varying NOT NULL,
+    status_reason character varying(512),
+    status_changed_at timestamp without time zone,
+    description character varying(512) DEFAULT ''::character varying NOT NULL,
+    short_description character varying(120) DEFAULT ''::character varying NOT NULL,
+    can_join_groups boolean DEFAULT false NOT NULL,
+    supports_inline_queries boolean DEFAULT false NOT NULL,
+    photo_file_unique_id character varying(64),
+    version bigint DEFAULT 1 NOT NULL
+);
+
+
+--
+-- Name: login_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.login_events (
+    id bigint NOT NULL,
+    method character varying(32) NOT NULL,
+    outcome character varying(16) NOT NULL,
+    client_id character varying(255),
+    bot_id bigint,
+    user_id bigint,
+    error_code character varying(64),
+    ip inet,
+    user_agent text,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
 );
 
 
+--
+-- Name: login_events_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.login_events_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: login_events_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.login_events_id_seq OWNED BY public.login_events.id;
+
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -64,6 +168,53 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: login_events id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.login_events ALTER COLUMN id SET DEFAULT nextval('public.login_events_id_seq'::regclass);
+
+
+--
+-- Name: bot_access_policies bot_access_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_access_policies
+    ADD CONSTRAINT bot_access_policies_pkey PRIMARY KEY (bot_id);
+
+
+--
+-- Name: bot_chats bot_chats_bot_id_group_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_chats
+    ADD CONSTRAINT bot_chats_bot_id_group_name_key UNIQUE (bot_id, group_name);
+
+
+--
+-- Name: bot_chats bot_chats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_chats
+    ADD CONSTRAINT bot_chats_pkey PRIMARY KEY (bot_id, chat_id);
+
+
+--
+-- Name: bot_claim_mappings bot_claim_mappings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_claim_mappings
+    ADD CONSTRAINT bot_claim_mappings_pkey PRIMARY KEY (bot_id, scope, claim);
+
+
+--
+-- Name: bot_photos bot_photos_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_photos
+    ADD CONSTRAINT bot_photos_pkey PRIMARY KEY (bot_id);
+
+
 --
 -- Name: bot_users bot_users_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -88,6 +239,14 @@ ALTER TABLE ONLY public.bots
     ADD CONSTRAINT bots_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: login_events login_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.login_events
+    ADD CONSTRAINT login_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -103,6 +262,20 @@ ALTER TABLE ONLY public.schema_migrations
 CREATE INDEX idx_bot_users_bot_id ON public.bot_users USING btree (bot_id);
 
 
+--
+-- Name: idx_bot_users_bot_id_last_login_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_bot_users_bot_id_last_login_at ON public.bot_users USING btree (bot_id, last_login_at DESC, user_id DESC);
+
+
+--
+-- Name: idx_bot_users_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_bot_users_user_id ON public.bot_users USING btree (user_id);
+
+
 --
 -- Name: idx_bots_client_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -110,6 +283,59 @@ CREATE INDEX idx_bot_users_bot_id ON public.bot_users USING btree (bot_id);
 CREATE INDEX idx_bots_client_id ON public.bots USING btree (client_id);
 
 
+--
+-- Name: idx_login_events_bot_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_login_events_bot_id_id ON public.login_events USING btree (bot_id, id DESC);
+
+
+--
+-- Name: idx_login_events_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_login_events_created_at ON public.login_events USING btree (created_at);
+
+
+--
+-- Name: idx_login_events_user_id_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_login_events_user_id_id ON public.login_events USING btree (user_id, id DESC);
+
+
+--
+-- Name: bot_access_policies fk_bot_access_policies_bot_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_access_policies
+    ADD CONSTRAINT fk_bot_access_policies_bot_id FOREIGN KEY (bot_id) REFERENCES public.bots(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bot_chats fk_bot_chats_bot_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_chats
+    ADD CONSTRAINT fk_bot_chats_bot_id FOREIGN KEY (bot_id) REFERENCES public.bots(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bot_claim_mappings fk_bot_claim_mappings_bot_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_claim_mappings
+    ADD CONSTRAINT fk_bot_claim_mappings_bot_id FOREIGN KEY (bot_id) REFERENCES public.bots(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bot_photos fk_bot_photos_bot_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bot_photos
+    ADD CONSTRAINT fk_bot_photos_bot_id FOREIGN KEY (bot_id) REFERENCES public.bots(id) ON DELETE CASCADE;
+
+
 --
 -- Name: bot_users fk_bot_users_bot_id; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -130,4 +356,18 @@ ALTER TABLE ONLY public.bot_users
 --
 
 INSERT INTO public.schema_migrations (version) VALUES
-    ('20260209122421');
+    ('20260209122421'),
+    ('20261018091204'),
+    ('20261018134511'),
+    ('20261018160233'),
+    ('20261018170512'),
+    ('20261018181047'),
+    ('20261018193326'),
+    ('20261018205418'),
+    ('20261018214730'),
+    ('20261018223104'),
+    ('20261018231542'),
+    ('20261018235817'),
+    ('20261019002114'),
+    ('20261019010542'),
+    ('20261019014210');
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+var (
+	// ErrAdminCredentialsMissing is returned when the request carries no credentials for the authenticator
+	ErrAdminCredentialsMissing = errors.New("admin credentials missing")
+
+	// ErrAdminCredentialsInvalid is returned when the request credentials are unknown, expired or malformed
+	ErrAdminCredentialsInvalid = errors.New("admin credentials invalid")
+)
+
+// AdminPrincipal identifies the caller of the private API.
+type AdminPrincipal struct {
+	KeyId  string
+	Scopes []string
+}
+
+// HasScopes reports whether the principal was granted every required scope.
+func (p *AdminPrincipal) HasScopes(required []string) bool {
+	for _, scope := range required {
+		if !slices.Contains(p.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// AdminAuthRequest holds the parts of an HTTP request used to authenticate private API calls.
+type AdminAuthRequest struct {
+	Method     string
+	RequestUri string // Path with raw query
+	Header     http.Header
+	Body       []byte
+}
+
+// AdminAuthenticator authenticates callers of the private API.
+type AdminAuthenticator interface {
+	Authenticate(ctx context.Context, request *AdminAuthRequest) (*AdminPrincipal, error)
+}
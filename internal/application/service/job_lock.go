@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrJobLocked is returned when the job is already run by another instance.
+var ErrJobLocked = errors.New("job is locked")
+
+// JobLock makes sure that a background job runs on a single instance at a time.
+type JobLock interface {
+	// TryLock takes the lock of the job for ttl, returning ErrJobLocked when it is held.
+	// The lock is not released explicitly and expires after ttl.
+	TryLock(ctx context.Context, job string, ttl time.Duration) error
+}
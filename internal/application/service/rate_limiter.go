@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimit allows up to Limit requests within a sliding Window.
+type RateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
+// RateLimitResult describes the decision of a rate limiter.
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration // Zero when the request is allowed
+}
+
+// RateLimiter counts requests per key and decides whether they fit the limit.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error)
+}
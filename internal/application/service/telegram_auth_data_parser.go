@@ -22,10 +22,11 @@ type TelegramUserData struct {
 
 // TelegramAuthData contains Telegram authentication data with signature verification.
 type TelegramAuthData struct {
-	Raw      string // Query string without "hash" parameter
-	Hash     string // HMAC-SHA256 signature signed with bot token
-	User     *TelegramUserData
-	AuthDate time.Time
+	Raw       string // Query string without "hash" parameter
+	Hash      string // HMAC-SHA256 signature signed with bot token
+	Signature string // Ed25519 signature signed by Telegram (Mini Apps only)
+	User      *TelegramUserData
+	AuthDate  time.Time
 }
 
 func (d *TelegramAuthData) IsExpired(ttl time.Duration) bool {
@@ -0,0 +1,11 @@
+package service
+
+import "context"
+
+// TelegramBotWebhook manages webhooks that deliver bot updates to the provider.
+type TelegramBotWebhook interface {
+	// SecretToken returns the expected X-Telegram-Bot-Api-Secret-Token value for the bot.
+	SecretToken(botId int64) string
+	// Register points the bot webhook to the given URL.
+	Register(ctx context.Context, botToken string, botId int64, url string) error
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrTelegramChatNotFound is returned when the chat does not exist or the bot cannot access it
+	ErrTelegramChatNotFound = errors.New("telegram chat not found")
+
+	// ErrChatMemberNotInCache is returned when chat membership is not found in cache
+	ErrChatMemberNotInCache = errors.New("chat member not found in cache")
+)
+
+// TelegramChatMemberStatus is the status of a user in a Telegram chat as reported by getChatMember.
+type TelegramChatMemberStatus string
+
+const (
+	TelegramChatMemberStatusCreator       TelegramChatMemberStatus = "creator"
+	TelegramChatMemberStatusAdministrator TelegramChatMemberStatus = "administrator"
+	TelegramChatMemberStatusMember        TelegramChatMemberStatus = "member"
+	TelegramChatMemberStatusRestricted    TelegramChatMemberStatus = "restricted"
+	TelegramChatMemberStatusLeft          TelegramChatMemberStatus = "left"
+	TelegramChatMemberStatusKicked        TelegramChatMemberStatus = "kicked"
+)
+
+// TelegramChatMember describes the membership of a user in a Telegram chat.
+type TelegramChatMember struct {
+	Status   TelegramChatMemberStatus
+	IsMember bool // Restricted users may or may not be members of the chat
+}
+
+// TelegramChatMemberChecker looks up the membership of users in Telegram chats on behalf of a bot.
+// The bot must be able to see the chat members, which usually means being a chat administrator.
+type TelegramChatMemberChecker interface {
+	GetChatMember(ctx context.Context, botToken string, chatId, userId int64) (*TelegramChatMember, error)
+}
+
+// TelegramChatMemberCache stores and retrieves chat memberships as seen by a bot.
+type TelegramChatMemberCache interface {
+	GetChatMember(ctx context.Context, botId, chatId, userId int64) (*TelegramChatMember, error)
+	CacheChatMember(ctx context.Context, botId, chatId, userId int64, member *TelegramChatMember) error
+}
@@ -4,3 +4,14 @@ package service
 type TelegramAuthHashVerifier interface {
 	Verify(query string, hash string, botToken string) error
 }
+
+// TelegramMiniAppAuthHashVerifier verifies HMAC-SHA256 signatures of Telegram Mini App init data.
+type TelegramMiniAppAuthHashVerifier interface {
+	Verify(query string, hash string, botToken string) error
+}
+
+// TelegramMiniAppSignatureVerifier verifies Ed25519 third-party signatures of Telegram Mini App init data.
+// Verification requires only the bot id and Telegram's public key, not the bot token.
+type TelegramMiniAppSignatureVerifier interface {
+	Verify(query string, signature string, botId int64) error
+}
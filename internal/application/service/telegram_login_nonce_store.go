@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrLoginNonceNotFound is returned when a login nonce does not exist or has expired
+	ErrLoginNonceNotFound = errors.New("login nonce not found")
+	// ErrLoginNonceConfirmed is returned when a login nonce has already been confirmed
+	ErrLoginNonceConfirmed = errors.New("login nonce already confirmed")
+	// ErrLoginHintBindingMismatch is returned when a login hint is used by another browser than the one it was issued to
+	ErrLoginHintBindingMismatch = errors.New("login hint binding mismatch")
+)
+
+// TelegramLoginNonce binds a one-time bot login nonce to a Hydra login challenge.
+// Nonces issued as OIDC login hints have an empty LoginChallenge until they are consumed.
+type TelegramLoginNonce struct {
+	BotId          int64
+	LoginChallenge string
+	User           *TelegramUserData // Set once the user has confirmed the login in the bot
+}
+
+func (n *TelegramLoginNonce) IsConfirmed() bool {
+	return n.User != nil
+}
+
+// TelegramLoginNonceStore stores short-lived nonces used by the bot deep link login.
+type TelegramLoginNonceStore interface {
+	Issue(ctx context.Context, botId int64, loginChallenge string, ttl time.Duration) (string, error)
+	Get(ctx context.Context, nonce string) (*TelegramLoginNonce, error)
+	// Confirm atomically sets the user of a pending nonce. Returns ErrLoginNonceConfirmed when the nonce
+	// has already been confirmed, so that a second confirmation cannot replace the user.
+	Confirm(ctx context.Context, nonce string, user *TelegramUserData) error
+	// Consume atomically removes the nonce and returns it, so that it completes a single login.
+	Consume(ctx context.Context, nonce string) (*TelegramLoginNonce, error)
+
+	// IssueLoginHint stores a login hint already confirmed for the user. Returns the hint and a secret
+	// binding it to the browser; the hint can only be consumed by presenting the binding.
+	IssueLoginHint(ctx context.Context, botId int64, user *TelegramUserData, ttl time.Duration) (string, string, error)
+	// ConsumeLoginHint atomically removes the login hint and returns it when the binding matches.
+	ConsumeLoginHint(ctx context.Context, loginHint string, binding string) (*TelegramLoginNonce, error)
+}
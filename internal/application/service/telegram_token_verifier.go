@@ -17,9 +17,26 @@ var (
 )
 
 type TelegramBotInfo struct {
-	Id       int64
-	Name     string
-	Username string
+	Id                    int64
+	Name                  string
+	Username              string
+	CanJoinGroups         bool
+	SupportsInlineQueries bool
+	Profile               *TelegramBotProfile // Set only when requested with WithProfile and fetched successfully
+}
+
+// TelegramBotProfile holds the public profile of a bot shown to users.
+type TelegramBotProfile struct {
+	Description      string
+	ShortDescription string
+	Photo            *TelegramBotPhoto // Nil when the bot has no profile photo
+}
+
+// TelegramBotPhoto is the downloaded profile photo of a bot.
+type TelegramBotPhoto struct {
+	FileUniqueId string
+	Content      []byte
+	ContentType  string
 }
 
 // TelegramTokenVerifier verifies the validity of a Telegram bot token.
@@ -37,6 +54,7 @@ type TelegramTokenVerificationCache interface {
 // VerifyOptions contains options for token verification.
 type VerifyOptions struct {
 	SkipCacheRead bool
+	WithProfile   bool
 }
 
 // VerifyOption is a functional option for VerifyOptions.
@@ -49,10 +67,19 @@ func WithSkipCacheRead() VerifyOption {
 	}
 }
 
+// WithProfile returns an option that also fetches the bot profile. Cached results carry no bot info,
+// so it is meant to be combined with WithSkipCacheRead.
+func WithProfile() VerifyOption {
+	return func(opts *VerifyOptions) {
+		opts.WithProfile = true
+	}
+}
+
 // NewVerifyOptions creates default VerifyOptions and applies provided options.
 func NewVerifyOptions(options ...VerifyOption) *VerifyOptions {
 	opts := &VerifyOptions{
 		SkipCacheRead: false,
+		WithProfile:   false,
 	}
 	for _, opt := range options {
 		opt(opts)
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type AcceptConsent struct {
+	hydra       *hydra.APIClient
+	botRepo     repository.BotRepositoryPort
+	botUserRepo repository.BotUserRepositoryPort
+	mappingRepo repository.BotClaimMappingRepositoryPort
+	memberships *chatMembershipResolver
+}
+
+func NewAcceptConsent(
+	hydraClient *hydra.APIClient,
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+	mappingRepo repository.BotClaimMappingRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+) (*AcceptConsent, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if mappingRepo == nil {
+		return nil, errors.New("bot claim mapping repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+
+	return &AcceptConsent{
+		hydra:       hydraClient,
+		botRepo:     botRepo,
+		botUserRepo: botUserRepo,
+		mappingRepo: mappingRepo,
+		memberships: newChatMembershipResolver(chatRepo, chatMemberChecker),
+	}, nil
+}
+
+type (
+	AcceptConsentInput struct {
+		ConsentChallenge string
+		Remember         bool
+	}
+	AcceptConsentOutput struct {
+		RedirectUri string
+	}
+)
+
+func (uc *AcceptConsent) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("consent", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *AcceptConsent) getBot(ctx context.Context, clientId string) (*entity.Bot, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByClientID(ctx, clientId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
+		}
+		return nil, ErrUnexpected
+	}
+	return &bot, nil
+}
+
+func (uc *AcceptConsent) parseSubjectUserId(subject *string) (int64, error) {
+	if subject == nil || *subject == "" {
+		return 0, NewObjectInvalidErr("consent", "subject", utils.Ptr("empty"))
+	}
+	userId, err := strconv.ParseInt(*subject, 10, 64)
+	if err != nil {
+		return 0, NewObjectInvalidErr("consent", "subject", nil)
+	}
+	return userId, nil
+}
+
+func (uc *AcceptConsent) getBotUser(ctx context.Context, botId, userId int64) (*entity.BotUser, error) {
+	var botUser entity.BotUser
+	if err := uc.botUserRepo.GetByBotAndUser(ctx, botId, userId, &botUser); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("user", userId)
+		}
+		return nil, ErrUnexpected
+	}
+	return &botUser, nil
+}
+
+func (uc *AcceptConsent) getClaimMappings(ctx context.Context, botId int64) ([]*entity.BotClaimMapping, error) {
+	mappings, err := uc.mappingRepo.GetByBot(ctx, botId)
+	if err != nil {
+		return nil, ErrUnexpected
+	}
+	return mappings, nil
+}
+
+func (uc *AcceptConsent) acceptConsentRequest(
+	ctx context.Context,
+	consentRequest *hydra.ConsentRequest,
+	botUser *entity.BotUser,
+	groups []string,
+	mappings []*entity.BotClaimMapping,
+	remember bool,
+) (*hydra.CompletedRequest, error) {
+	session := hydra.NewConsentRequestSession()
+	session.SetIdToken(buildIdTokenClaims(botUser, consentRequest.RequestedScope, groups, mappings))
+
+	acceptReq := hydra.NewAcceptConsentRequest()
+	acceptReq.SetGrantScope(consentRequest.RequestedScope)
+	acceptReq.SetGrantAccessTokenAudience(consentRequest.RequestedAccessTokenAudience)
+	acceptReq.SetSession(*session)
+	acceptReq.SetRemember(remember)
+
+	completed, resp, err := uc.hydra.AdminApi.
+		AcceptConsentRequest(ctx).
+		ConsentChallenge(consentRequest.Challenge).
+		AcceptConsentRequest(*acceptReq).
+		Execute()
+	if err != nil {
+		return nil, mapHydraChallengeError(err, resp, "consent")
+	}
+
+	return completed, nil
+}
+
+func (uc *AcceptConsent) rejectAfterChallenge(ctx context.Context, consentChallenge string, reason error) (*AcceptConsentOutput, error) {
+	zerolog.Ctx(ctx).Warn().
+		Err(reason).
+		Str("consent_challenge", consentChallenge).
+		Msg("accept consent failed, rejecting consent request in hydra")
+
+	redirectUri, err := rejectConsentRequest(ctx, uc.hydra, consentChallenge, reason)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("consent_challenge", consentChallenge).
+			Msg("failed to reject consent request in hydra")
+		return nil, err
+	}
+
+	return &AcceptConsentOutput{RedirectUri: redirectUri}, nil
+}
+
+func (uc *AcceptConsent) Execute(ctx context.Context, input *AcceptConsentInput) (*AcceptConsentOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	challenge := input.ConsentChallenge
+	if err := uc.verifyChallenge(challenge); err != nil {
+		return nil, err
+	}
+
+	consentRequest, err := getConsentRequest(ctx, uc.hydra, challenge)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+	clientId := *consentRequest.Client.ClientId
+
+	bot, err := uc.getBot(ctx, clientId)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	userId, err := uc.parseSubjectUserId(consentRequest.Subject)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	botUser, err := uc.getBotUser(ctx, bot.Id, userId)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	mappings, err := uc.getClaimMappings(ctx, bot.Id)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	groups, err := uc.memberships.resolveGroupsClaim(ctx, bot, userId, consentRequest.RequestedScope)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	completed, err := uc.acceptConsentRequest(ctx, consentRequest, botUser, groups, mappings, input.Remember)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return uc.rejectAfterChallenge(ctx, challenge, ErrUnexpected)
+	}
+
+	return &AcceptConsentOutput{RedirectUri: completed.RedirectTo}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	hydra "github.com/ory/hydra-client-go"
+)
+
+type AcceptLogout struct {
+	hydra *hydra.APIClient
+}
+
+func NewAcceptLogout(hydraClient *hydra.APIClient) (*AcceptLogout, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &AcceptLogout{
+		hydra: hydraClient,
+	}, nil
+}
+
+type (
+	AcceptLogoutInput struct {
+		LogoutChallenge string
+	}
+	AcceptLogoutOutput struct {
+		RedirectUri string
+	}
+)
+
+func (uc *AcceptLogout) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *AcceptLogout) acceptLogoutRequest(ctx context.Context, logoutChallenge string) (*hydra.CompletedRequest, error) {
+	completed, resp, err := uc.hydra.AdminApi.
+		AcceptLogoutRequest(ctx).
+		LogoutChallenge(logoutChallenge).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+
+	return completed, nil
+}
+
+func (uc *AcceptLogout) Execute(ctx context.Context, input *AcceptLogoutInput) (*AcceptLogoutOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyChallenge(input.LogoutChallenge); err != nil {
+		return nil, err
+	}
+
+	completed, err := uc.acceptLogoutRequest(ctx, input.LogoutChallenge)
+	if err != nil {
+		return nil, err
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return nil, ErrUnexpected
+	}
+
+	return &AcceptLogoutOutput{RedirectUri: completed.RedirectTo}, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+// accessPolicyChecker evaluates the access policy and the chats of a bot for a Telegram user.
+// Bots without a policy and chats admit every user.
+type accessPolicyChecker struct {
+	policyRepo  repository.BotAccessPolicyRepositoryPort
+	memberships *chatMembershipResolver
+}
+
+func newAccessPolicyChecker(
+	policyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+) *accessPolicyChecker {
+	return &accessPolicyChecker{
+		policyRepo:  policyRepo,
+		memberships: newChatMembershipResolver(chatRepo, chatMemberChecker),
+	}
+}
+
+// checkTelegramUser evaluates the policy for the user of a verified Telegram login payload.
+func (c *accessPolicyChecker) checkTelegramUser(
+	ctx context.Context,
+	bot *entity.Bot,
+	tgUser *service.TelegramUserData,
+	language *string,
+) error {
+	user, err := entity.NewUser(tgUser.FirstName, tgUser.LastName, tgUser.Username, tgUser.PhotoUrl, tgUser.IsPremium)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "profile", nil))
+	}
+	return c.check(ctx, bot, tgUser.Id, user, language)
+}
+
+func (c *accessPolicyChecker) check(
+	ctx context.Context,
+	bot *entity.Bot,
+	userId int64,
+	user *entity.User,
+	language *string,
+) error {
+	if err := c.checkPolicy(ctx, bot, userId, user, language); err != nil {
+		return err
+	}
+	return c.checkChats(ctx, bot, userId)
+}
+
+func (c *accessPolicyChecker) checkPolicy(
+	ctx context.Context,
+	bot *entity.Bot,
+	userId int64,
+	user *entity.User,
+	language *string,
+) error {
+	log := zerolog.Ctx(ctx).With().Int64("bot_id", bot.Id).Int64("user_id", userId).Logger()
+
+	var policy entity.BotAccessPolicy
+	if err := c.policyRepo.GetByBot(ctx, bot.Id, &policy); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		log.Error().Err(err).Msg("failed to load bot access policy")
+		return ErrUnexpected
+	}
+
+	if err := policy.Evaluate(userId, user, language); err != nil {
+		log.Info().Err(err).Msg("login denied by bot access policy")
+		return NewAccessDeniedErr(err.Error())
+	}
+	return nil
+}
+
+// checkChats admits the user when the bot has no chats or the user is a member of any of them.
+func (c *accessPolicyChecker) checkChats(ctx context.Context, bot *entity.Bot, userId int64) error {
+	chats, memberships, err := c.memberships.resolve(ctx, bot, userId)
+	if err != nil {
+		return err
+	}
+	if len(chats) > 0 && len(memberships) == 0 {
+		zerolog.Ctx(ctx).Info().
+			Int64("bot_id", bot.Id).
+			Int64("user_id", userId).
+			Msg("login denied: user is not a member of any bot chat")
+		return NewAccessDeniedErr("user is not a member of any bot chat")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+// BotUnavailableReason explains why a bot cannot serve logins.
+type BotUnavailableReason string
+
+const (
+	// BotUnavailableReasonDisabled means an administrator disabled the bot.
+	BotUnavailableReasonDisabled BotUnavailableReason = "disabled"
+	// BotUnavailableReasonMaintenance means an administrator put the bot under maintenance.
+	BotUnavailableReasonMaintenance BotUnavailableReason = "maintenance"
+	// BotUnavailableReasonCredentialsInvalid means Telegram rejected the bot token on the last check.
+	BotUnavailableReasonCredentialsInvalid BotUnavailableReason = "credentials_invalid"
+)
+
+// checkBotAvailability rejects logins to bots that cannot serve them.
+func checkBotAvailability(bot *entity.Bot) error {
+	switch bot.Status {
+	case entity.BotStatusDisabled:
+		return NewBotUnavailableErr(BotUnavailableReasonDisabled, bot.StatusReason)
+	case entity.BotStatusMaintenance:
+		return NewBotUnavailableErr(BotUnavailableReasonMaintenance, bot.StatusReason)
+	}
+	if !bot.HasValidCredentials() {
+		return NewBotUnavailableErr(BotUnavailableReasonCredentialsInvalid, nil)
+	}
+	return nil
+}
+
+// mapBotUnavailableError maps a bot availability error to the OAuth2 error, status code and
+// description of a rejected login request. The reason set by an administrator is appended to the description.
+func mapBotUnavailableError(err *BotUnavailableErr) (string, int64, string) {
+	var (
+		oauth2Error string
+		status      int64
+		description string
+	)
+	switch err.Reason {
+	case BotUnavailableReasonDisabled:
+		oauth2Error, status, description = "access_denied", http.StatusForbidden,
+			"application is disabled, sign in is not available"
+	case BotUnavailableReasonMaintenance:
+		oauth2Error, status, description = "temporarily_unavailable", http.StatusServiceUnavailable,
+			"application is under maintenance, try again later"
+	case BotUnavailableReasonCredentialsInvalid:
+		oauth2Error, status, description = "temporarily_unavailable", http.StatusServiceUnavailable,
+			"bot credentials were revoked, the application owner must update the bot token"
+	default:
+		oauth2Error, status, description = "temporarily_unavailable", http.StatusServiceUnavailable,
+			"application is unavailable"
+	}
+	if err.Detail != nil {
+		description += ": " + *err.Detail
+	}
+	return oauth2Error, status, description
+}
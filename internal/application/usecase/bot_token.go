@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+// getBotToken loads the token of the bot, which is only decrypted when a login actually needs it.
+func getBotToken(ctx context.Context, bot *entity.Bot) (string, error) {
+	token, err := bot.Token()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Int64("bot_id", bot.Id).
+			Msg("failed to load bot token")
+		return "", ErrUnexpected
+	}
+	return token, nil
+}
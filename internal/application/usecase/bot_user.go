@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+// maxBotUserSaveAttempts bounds how many times a login retries saving the bot user
+// after losing a race against a concurrent login of the same user.
+const maxBotUserSaveAttempts = 3
+
+// errBotUserChanged signals that the bot user was created or modified concurrently
+// and the save must be retried against the fresh row.
+var errBotUserChanged = errors.New("bot user changed concurrently")
+
+// saveBotUser creates the bot user on the first login and refreshes the stored profile,
+// IP, user agent, language and last login time on every following one. Each attempt runs
+// in its own transaction, so a lost optimistic concurrency race can be retried cleanly.
+func saveBotUser(
+	ctx context.Context,
+	transactor service.Transactor,
+	botUserRepo repository.BotUserRepositoryPort,
+	botId int64,
+	tgUser *service.TelegramUserData,
+	clientIP netip.Addr,
+	userAgent *string,
+	language *string,
+) error {
+	user, err := entity.NewUser(tgUser.FirstName, tgUser.LastName, tgUser.Username, tgUser.PhotoUrl, tgUser.IsPremium)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "profile", nil))
+	}
+
+	for range maxBotUserSaveAttempts {
+		err := transactor.RunInTransaction(ctx, func(txCtx context.Context) error {
+			return trySaveBotUser(txCtx, botUserRepo, botId, tgUser.Id, user, clientIP, userAgent, language)
+		})
+		if !errors.Is(err, errBotUserChanged) {
+			return err
+		}
+	}
+
+	zerolog.Ctx(ctx).Error().
+		Int64("bot_id", botId).
+		Int64("user_id", tgUser.Id).
+		Int("attempts", maxBotUserSaveAttempts).
+		Msg("failed to save bot user due to concurrent modifications")
+	return ErrUnexpected
+}
+
+func trySaveBotUser(
+	ctx context.Context,
+	botUserRepo repository.BotUserRepositoryPort,
+	botId, userId int64,
+	user *entity.User,
+	clientIP netip.Addr,
+	userAgent *string,
+	language *string,
+) error {
+	var botUser entity.BotUser
+	err := botUserRepo.GetByBotAndUser(ctx, botId, userId, &botUser)
+	if errors.Is(err, repository.ErrNotFound) {
+		return createBotUser(ctx, botUserRepo, botId, userId, user, clientIP, userAgent, language)
+	}
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Int64("bot_id", botId).
+			Int64("user_id", userId).
+			Msg("failed to load bot user by bot and user ids")
+		return ErrUnexpected
+	}
+
+	if err := botUser.SetUser(user); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "profile", nil))
+	}
+	if err := botUser.SetIP(clientIP); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_user", "ip", nil))
+	}
+	botUser.SetUserAgent(userAgent)
+	// Telegram only sends the language on some login methods, keep the known one otherwise.
+	if language != nil {
+		botUser.SetLanguage(language)
+	}
+	botUser.UpdateLastLogin()
+
+	if err := botUserRepo.Update(ctx, &botUser); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) || errors.Is(err, repository.ErrNotFound) {
+			return errBotUserChanged
+		}
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Int64("bot_id", botId).
+			Int64("user_id", userId).
+			Msg("failed to update bot user")
+		return ErrUnexpected
+	}
+
+	return nil
+}
+
+func createBotUser(
+	ctx context.Context,
+	botUserRepo repository.BotUserRepositoryPort,
+	botId, userId int64,
+	user *entity.User,
+	clientIP netip.Addr,
+	userAgent *string,
+	language *string,
+) error {
+	newBotUser, err := entity.NewBotUser(botId, userId, user, clientIP, userAgent, language)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_user", "data", nil))
+	}
+
+	if err := botUserRepo.Create(ctx, newBotUser); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return errBotUserChanged
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("bot", botId))
+		}
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Int64("bot_id", botId).
+			Int64("user_id", userId).
+			Msg("failed to create bot user")
+		return ErrUnexpected
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+const scopeGroups = "groups"
+
+// chatMembership is the membership of a user in a chat bound to a bot.
+type chatMembership struct {
+	chat   *entity.BotChat
+	status service.TelegramChatMemberStatus
+}
+
+// chatMembershipResolver looks up the memberships of a user in the chats bound to a bot.
+type chatMembershipResolver struct {
+	chatRepo          repository.BotChatRepositoryPort
+	chatMemberChecker service.TelegramChatMemberChecker
+}
+
+func newChatMembershipResolver(
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+) *chatMembershipResolver {
+	return &chatMembershipResolver{chatRepo: chatRepo, chatMemberChecker: chatMemberChecker}
+}
+
+// resolve returns the chats bound to the bot and the memberships of the user among them.
+// Chats the bot cannot access are skipped, so a misconfigured chat never grants access.
+func (r *chatMembershipResolver) resolve(ctx context.Context, bot *entity.Bot, userId int64) ([]*entity.BotChat, []chatMembership, error) {
+	log := zerolog.Ctx(ctx).With().Int64("bot_id", bot.Id).Int64("user_id", userId).Logger()
+
+	chats, err := r.chatRepo.GetByBot(ctx, bot.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load bot chats")
+		return nil, nil, ErrUnexpected
+	}
+
+	if len(chats) == 0 {
+		return chats, nil, nil
+	}
+
+	botToken, err := getBotToken(ctx, bot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memberships := make([]chatMembership, 0, len(chats))
+	for _, chat := range chats {
+		member, err := r.chatMemberChecker.GetChatMember(ctx, botToken, chat.ChatId, userId)
+		if err != nil {
+			if errors.Is(err, service.ErrTelegramChatNotFound) {
+				log.Error().Int64("chat_id", chat.ChatId).Msg("bot chat is not accessible by the bot")
+				continue
+			}
+			return nil, nil, NewBadGatewayErr("telegram")
+		}
+		if !member.IsMember {
+			continue
+		}
+
+		status := member.Status
+		if status == service.TelegramChatMemberStatusRestricted {
+			status = service.TelegramChatMemberStatusMember
+		}
+		memberships = append(memberships, chatMembership{chat: chat, status: status})
+	}
+
+	return chats, memberships, nil
+}
+
+// resolveGroupsClaim returns the groups claim of the user when the groups scope is granted
+// and the bot has chats, nil otherwise.
+func (r *chatMembershipResolver) resolveGroupsClaim(ctx context.Context, bot *entity.Bot, userId int64, grantedScopes []string) ([]string, error) {
+	if !slices.Contains(grantedScopes, scopeGroups) {
+		return nil, nil
+	}
+
+	chats, memberships, err := r.resolve(ctx, bot, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(chats) == 0 {
+		return nil, nil
+	}
+
+	return buildGroupsClaim(memberships), nil
+}
+
+// buildGroupsClaim names the chats the user belongs to. Administrators and creators
+// also get a "<group>:<status>" entry so clients can tell chat roles apart.
+func buildGroupsClaim(memberships []chatMembership) []string {
+	groups := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		groups = append(groups, membership.chat.Group)
+		if membership.status != service.TelegramChatMemberStatusMember {
+			groups = append(groups, membership.chat.Group+":"+string(membership.status))
+		}
+	}
+	return groups
+}
@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+const (
+	botLoginStartCommand = "/start"
+	// botLoginCallbackPrefix marks the callback data of the button that confirms a bot login.
+	botLoginCallbackPrefix = "login:"
+
+	botLoginConfirmPrompt         = "Someone is signing in to %s with your Telegram account. Confirm only if it is you."
+	botLoginConfirmButton         = "Confirm sign-in to %s"
+	botLoginConfirmedReply        = "You are signed in to %s. Return to the browser to continue."
+	botLoginExpiredReply          = "This login link has expired. Start the login again."
+	botLoginAlreadyConfirmedReply = "This login link has already been used."
+)
+
+type ConfirmLoginByBot struct {
+	hydra      *hydra.APIClient
+	botRepo    repository.BotRepositoryPort
+	webhook    service.TelegramBotWebhook
+	nonceStore service.TelegramLoginNonceStore
+}
+
+func NewConfirmLoginByBot(
+	hydraClient *hydra.APIClient,
+	botRepo repository.BotRepositoryPort,
+	webhook service.TelegramBotWebhook,
+	nonceStore service.TelegramLoginNonceStore,
+) (*ConfirmLoginByBot, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if webhook == nil {
+		return nil, errors.New("bot webhook is nil")
+	}
+	if nonceStore == nil {
+		return nil, errors.New("login nonce store is nil")
+	}
+
+	return &ConfirmLoginByBot{
+		hydra:      hydraClient,
+		botRepo:    botRepo,
+		webhook:    webhook,
+		nonceStore: nonceStore,
+	}, nil
+}
+
+type (
+	ConfirmLoginByBotInput struct {
+		BotId        int64
+		SecretToken  string
+		ChatId       int64
+		MessageId    int64
+		Text         string
+		CallbackData string // Set when the update is a press of an inline button
+		User         *service.TelegramUserData
+	}
+	ConfirmLoginByBotOutput struct {
+		ChatId        int64
+		MessageId     *int64                   // Set when the reply replaces the message with the confirm button
+		ReplyText     *string                  // Nil when the update is not a bot login
+		ConfirmButton *ConfirmLoginByBotButton // Set when the reply asks the user to confirm the login
+	}
+	ConfirmLoginByBotButton struct {
+		Text         string
+		CallbackData string
+	}
+)
+
+func (uc *ConfirmLoginByBot) verifySecretToken(botId int64, secretToken string) error {
+	expected := uc.webhook.SecretToken(botId)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(secretToken)) != 1 {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("webhook", "secret_token", nil))
+	}
+	return nil
+}
+
+func (uc *ConfirmLoginByBot) verifyBotExists(ctx context.Context, botId int64) error {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, botId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return NewObjectNotFoundErr("bot", botId)
+		}
+		return ErrUnexpected
+	}
+	return nil
+}
+
+// parseStartNonce extracts the nonce from a "/start <nonce>" message.
+func (uc *ConfirmLoginByBot) parseStartNonce(text string) (string, bool) {
+	command, payload, found := strings.Cut(strings.TrimSpace(text), " ")
+	if !found || command != botLoginStartCommand {
+		return "", false
+	}
+
+	nonce := strings.TrimSpace(payload)
+	if nonce == "" {
+		return "", false
+	}
+
+	return nonce, true
+}
+
+// parseCallbackNonce extracts the nonce from the callback data of the confirm button.
+func (uc *ConfirmLoginByBot) parseCallbackNonce(data string) (string, bool) {
+	nonce, found := strings.CutPrefix(data, botLoginCallbackPrefix)
+	if !found || nonce == "" {
+		return "", false
+	}
+
+	return nonce, true
+}
+
+// getPendingNonce loads a login nonce that still waits for confirmation in the bot.
+// When the nonce cannot be confirmed, the returned reply explains why.
+func (uc *ConfirmLoginByBot) getPendingNonce(ctx context.Context, botId int64, nonce string) (*service.TelegramLoginNonce, string, error) {
+	loginNonce, err := uc.nonceStore.Get(ctx, nonce)
+	if err != nil {
+		if errors.Is(err, service.ErrLoginNonceNotFound) {
+			return nil, botLoginExpiredReply, nil
+		}
+		return nil, "", NewBadGatewayErr("telegram_login_nonce_store")
+	}
+	if loginNonce.BotId != botId {
+		return nil, botLoginExpiredReply, nil
+	}
+	if loginNonce.IsConfirmed() {
+		return nil, botLoginAlreadyConfirmedReply, nil
+	}
+	// Login hints are confirmed when issued, so a pending nonce always belongs to a login challenge
+	if loginNonce.LoginChallenge == "" {
+		return nil, botLoginExpiredReply, nil
+	}
+
+	return loginNonce, "", nil
+}
+
+// getClientName returns the name of the application the login challenge signs in to,
+// falling back to its client id. Returns an empty name when the challenge is no longer valid.
+func (uc *ConfirmLoginByBot) getClientName(ctx context.Context, loginChallenge string) (string, error) {
+	loginRequest, err := getLoginRequest(ctx, uc.hydra, loginChallenge)
+	if err != nil {
+		if errors.Is(err, ErrInvalidInput) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if name := loginRequest.Client.GetClientName(); name != "" {
+		return name, nil
+	}
+	return *loginRequest.Client.ClientId, nil
+}
+
+// promptNonce asks the user to confirm the login with a button naming the application,
+// so that opening a login link alone never signs anyone in.
+func (uc *ConfirmLoginByBot) promptNonce(ctx context.Context, botId int64, nonce string, output *ConfirmLoginByBotOutput) error {
+	loginNonce, reply, err := uc.getPendingNonce(ctx, botId, nonce)
+	if err != nil {
+		return err
+	}
+	if loginNonce == nil {
+		output.ReplyText = utils.Ptr(reply)
+		return nil
+	}
+
+	clientName, err := uc.getClientName(ctx, loginNonce.LoginChallenge)
+	if err != nil {
+		return err
+	}
+	if clientName == "" {
+		output.ReplyText = utils.Ptr(botLoginExpiredReply)
+		return nil
+	}
+
+	output.ReplyText = utils.Ptr(fmt.Sprintf(botLoginConfirmPrompt, clientName))
+	output.ConfirmButton = &ConfirmLoginByBotButton{
+		Text:         fmt.Sprintf(botLoginConfirmButton, clientName),
+		CallbackData: botLoginCallbackPrefix + nonce,
+	}
+	return nil
+}
+
+func (uc *ConfirmLoginByBot) confirmNonce(ctx context.Context, botId int64, nonce string, user *service.TelegramUserData) (string, error) {
+	loginNonce, reply, err := uc.getPendingNonce(ctx, botId, nonce)
+	if err != nil {
+		return "", err
+	}
+	if loginNonce == nil {
+		return reply, nil
+	}
+
+	clientName, err := uc.getClientName(ctx, loginNonce.LoginChallenge)
+	if err != nil {
+		return "", err
+	}
+	if clientName == "" {
+		return botLoginExpiredReply, nil
+	}
+
+	if err := uc.nonceStore.Confirm(ctx, nonce, user); err != nil {
+		if errors.Is(err, service.ErrLoginNonceNotFound) {
+			return botLoginExpiredReply, nil
+		}
+		if errors.Is(err, service.ErrLoginNonceConfirmed) {
+			return botLoginAlreadyConfirmedReply, nil
+		}
+		return "", NewBadGatewayErr("telegram_login_nonce_store")
+	}
+
+	return fmt.Sprintf(botLoginConfirmedReply, clientName), nil
+}
+
+func (uc *ConfirmLoginByBot) Execute(ctx context.Context, input *ConfirmLoginByBotInput) (*ConfirmLoginByBotOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifySecretToken(input.BotId, input.SecretToken); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyBotExists(ctx, input.BotId); err != nil {
+		return nil, err
+	}
+
+	output := &ConfirmLoginByBotOutput{ChatId: input.ChatId}
+	if input.User == nil {
+		return output, nil
+	}
+
+	log := zerolog.Ctx(ctx).With().
+		Int64("bot_id", input.BotId).
+		Int64("user_id", input.User.Id).
+		Logger()
+
+	if nonce, ok := uc.parseCallbackNonce(input.CallbackData); ok {
+		reply, err := uc.confirmNonce(ctx, input.BotId, nonce, input.User)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to confirm bot login")
+			return nil, err
+		}
+
+		output.MessageId = utils.Ptr(input.MessageId)
+		output.ReplyText = utils.Ptr(reply)
+		return output, nil
+	}
+
+	if nonce, ok := uc.parseStartNonce(input.Text); ok {
+		if err := uc.promptNonce(ctx, input.BotId, nonce, output); err != nil {
+			log.Error().Err(err).Msg("failed to prompt bot login confirmation")
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+const scopeProfile = "profile"
+
+// buildIdTokenClaims maps a bot user to the standard OpenID Connect claims allowed by the granted scopes.
+// The bot's claim mappings take precedence over claims with the same name. The groups claim is reserved
+// and added last when groups are given, so that a mapping never overrides the chat memberships.
+func buildIdTokenClaims(botUser *entity.BotUser, grantedScopes []string, groups []string, mappings []*entity.BotClaimMapping) map[string]any {
+	claims := make(map[string]any)
+
+	if slices.Contains(grantedScopes, scopeProfile) {
+		user := botUser.User
+
+		nameParts := []string{user.FirstName}
+		claims["given_name"] = user.FirstName
+		if user.LastName != nil {
+			nameParts = append(nameParts, *user.LastName)
+			claims["family_name"] = *user.LastName
+		}
+		claims["name"] = strings.Join(nameParts, " ")
+
+		if user.Username != nil {
+			claims["preferred_username"] = *user.Username
+		}
+		if user.PhotoUrl != nil {
+			claims["picture"] = user.PhotoUrl.String()
+		}
+		if botUser.Language != nil {
+			claims["locale"] = *botUser.Language
+		}
+		claims["updated_at"] = botUser.ModifiedAt().Unix()
+	}
+
+	for _, mapping := range mappings {
+		if !slices.Contains(grantedScopes, mapping.Scope) {
+			continue
+		}
+		if value := mapping.Resolve(botUser); value != nil {
+			claims[mapping.Claim] = value
+		}
+	}
+
+	if groups != nil {
+		claims["groups"] = groups
+	}
+
+	return claims
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	hydraclient "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+var (
+	defaultClientGrantTypes              = []string{"authorization_code", "refresh_token"}
+	defaultClientScopes                  = []string{"openid", "profile"}
+	defaultClientTokenEndpointAuthMethod = "client_secret_basic"
+)
+
+// CreateBotClient provisions a new OAuth2 client in Hydra and links it to the bot.
+// When the bot cannot be linked, the created client is deleted from Hydra again.
+type CreateBotClient struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+	hydra      *hydraclient.APIClient
+}
+
+func NewCreateBotClient(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+	hydraClient *hydraclient.APIClient,
+) (*CreateBotClient, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &CreateBotClient{
+		transactor: transactor,
+		botRepo:    botRepo,
+		hydra:      hydraClient,
+	}, nil
+}
+
+type (
+	CreateBotClientInput struct {
+		BotId                   int64
+		ClientName              *string // Defaults to the bot name
+		RedirectUris            []string
+		GrantTypes              []string // Defaults to authorization_code and refresh_token
+		Scopes                  []string // Defaults to openid and profile
+		TokenEndpointAuthMethod *string  // Defaults to client_secret_basic
+	}
+	CreateBotClientOutput struct {
+		BotId                   int64
+		ClientId                string
+		ClientSecret            *string // Only returned once, nil for public clients
+		ClientName              string
+		RedirectUris            []string
+		GrantTypes              []string
+		Scopes                  []string
+		TokenEndpointAuthMethod string
+	}
+)
+
+func (uc *CreateBotClient) verifyRedirectUris(redirectUris []string) error {
+	if len(redirectUris) == 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", "redirect_uris", utils.Ptr("empty")))
+	}
+	for _, redirectUri := range redirectUris {
+		uri, err := url.Parse(redirectUri)
+		if err != nil || uri.Scheme == "" || uri.Host == "" {
+			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", "redirect_uris", utils.Ptr("must be absolute URIs")))
+		}
+		if uri.Fragment != "" {
+			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", "redirect_uris", utils.Ptr("must not contain a fragment")))
+		}
+	}
+	return nil
+}
+
+func (uc *CreateBotClient) getUnlinkedBot(ctx context.Context, botId int64) (*entity.Bot, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, botId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot", botId)
+		}
+		return nil, fmt.Errorf("%w: failed to get bot by ID", ErrUnexpected)
+	}
+	if bot.ClientId != nil {
+		return nil, NewConflictErr("bot", nil)
+	}
+	return &bot, nil
+}
+
+// buildResponseTypes derives the response types Hydra requires for the requested grant types.
+func (uc *CreateBotClient) buildResponseTypes(grantTypes []string) []string {
+	responseTypes := make([]string, 0, 3)
+	if slices.Contains(grantTypes, "authorization_code") {
+		responseTypes = append(responseTypes, "code")
+	}
+	if slices.Contains(grantTypes, "implicit") {
+		responseTypes = append(responseTypes, "id_token", "token")
+	}
+	return responseTypes
+}
+
+func (uc *CreateBotClient) buildClient(bot *entity.Bot, input *CreateBotClientInput) *hydraclient.OAuth2Client {
+	clientName := bot.Name
+	if input.ClientName != nil && *input.ClientName != "" {
+		clientName = *input.ClientName
+	}
+	grantTypes := input.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = defaultClientGrantTypes
+	}
+	scopes := input.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultClientScopes
+	}
+	tokenEndpointAuthMethod := defaultClientTokenEndpointAuthMethod
+	if input.TokenEndpointAuthMethod != nil && *input.TokenEndpointAuthMethod != "" {
+		tokenEndpointAuthMethod = *input.TokenEndpointAuthMethod
+	}
+
+	client := hydraclient.NewOAuth2Client()
+	client.SetClientName(clientName)
+	client.SetRedirectUris(input.RedirectUris)
+	client.SetGrantTypes(grantTypes)
+	client.SetResponseTypes(uc.buildResponseTypes(grantTypes))
+	client.SetScope(strings.Join(scopes, " "))
+	client.SetTokenEndpointAuthMethod(tokenEndpointAuthMethod)
+	return client
+}
+
+func (uc *CreateBotClient) createClient(ctx context.Context, client *hydraclient.OAuth2Client) (*hydraclient.OAuth2Client, error) {
+	created, resp, err := uc.hydra.AdminApi.
+		CreateOAuth2Client(ctx).
+		OAuth2Client(*client).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusBadRequest {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", "metadata", nil))
+			}
+			if resp.StatusCode == http.StatusConflict {
+				return nil, NewConflictErr("client", nil)
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+	if created == nil || created.GetClientId() == "" {
+		return nil, ErrUnexpected
+	}
+
+	return created, nil
+}
+
+// deleteClient compensates a client creation whose bot link could not be stored.
+func (uc *CreateBotClient) deleteClient(ctx context.Context, clientId string) {
+	resp, err := uc.hydra.AdminApi.DeleteOAuth2Client(ctx, clientId).Execute()
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("client_id", clientId).
+			Msg("failed to delete orphaned oauth2 client from hydra")
+	}
+}
+
+func (uc *CreateBotClient) linkBot(ctx context.Context, botId int64, clientId string) error {
+	bot, err := uc.getUnlinkedBot(ctx, botId)
+	if err != nil {
+		return err
+	}
+
+	if err := bot.SetClientId(utils.Ptr(clientId)); err != nil {
+		return fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("client", "id", nil), err)
+	}
+
+	if err := uc.botRepo.Update(ctx, bot); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) {
+			return NewConflictErr("bot", nil)
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return NewConflictErr("bot", utils.Ptr("client_id"))
+		}
+		return fmt.Errorf("%w: failed to update bot", ErrUnexpected)
+	}
+	return nil
+}
+
+func (uc *CreateBotClient) buildOutput(botId int64, client *hydraclient.OAuth2Client) *CreateBotClientOutput {
+	output := &CreateBotClientOutput{
+		BotId:                   botId,
+		ClientId:                client.GetClientId(),
+		ClientName:              client.GetClientName(),
+		RedirectUris:            client.RedirectUris,
+		GrantTypes:              client.GrantTypes,
+		Scopes:                  strings.Fields(client.GetScope()),
+		TokenEndpointAuthMethod: client.GetTokenEndpointAuthMethod(),
+	}
+	if secret := client.GetClientSecret(); secret != "" {
+		output.ClientSecret = utils.Ptr(secret)
+	}
+	return output
+}
+
+func (uc *CreateBotClient) Execute(ctx context.Context, input *CreateBotClientInput) (*CreateBotClientOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyRedirectUris(input.RedirectUris); err != nil {
+		return nil, err
+	}
+
+	// Fail fast before touching Hydra, the link is checked again inside the transaction
+	bot, err := uc.getUnlinkedBot(ctx, input.BotId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uc.createClient(ctx, uc.buildClient(bot, input))
+	if err != nil {
+		return nil, err
+	}
+	clientId := client.GetClientId()
+
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		return uc.linkBot(ctx, input.BotId, clientId)
+	}); err != nil {
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Int64("bot_id", input.BotId).
+			Str("client_id", clientId).
+			Msg("failed to link bot to created client, deleting client from hydra")
+		uc.deleteClient(context.WithoutCancel(ctx), clientId)
+		return nil, err
+	}
+
+	return uc.buildOutput(input.BotId, client), nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+
+	cursorSeparator = "|"
+)
+
+// encodeCursor builds an opaque pagination cursor from the keyset of the last returned item.
+func encodeCursor(parts ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, cursorSeparator)))
+}
+
+// decodeCursor restores the keyset encoded by encodeCursor and checks the number of parts.
+func decodeCursor(cursor string, partsCount int) ([]string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "cursor", utils.Ptr("malformed")))
+	}
+	parts := strings.Split(string(raw), cursorSeparator)
+	if len(parts) != partsCount {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "cursor", utils.Ptr("malformed")))
+	}
+	return parts, nil
+}
+
+// decodeIdCursor restores an ID encoded as a single part cursor.
+func decodeIdCursor(cursor string) (int64, error) {
+	parts, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "cursor", utils.Ptr("malformed")))
+	}
+	return id, nil
+}
+
+// normalizePageLimit applies the default page size and caps it at the maximum.
+func normalizePageLimit(limit *int) (int, error) {
+	if limit == nil {
+		return defaultPageLimit, nil
+	}
+	if *limit <= 0 || *limit > maxPageLimit {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "limit", utils.Ptr(fmt.Sprintf("must be between 1 and %d", maxPageLimit))))
+	}
+	return *limit, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+// DeleteBot removes the bot together with its users and claim mappings.
+// The OAuth2 client linked to the bot is kept in Hydra.
+type DeleteBot struct {
+	botRepo repository.BotRepositoryPort
+}
+
+func NewDeleteBot(botRepo repository.BotRepositoryPort) (*DeleteBot, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+
+	return &DeleteBot{
+		botRepo: botRepo,
+	}, nil
+}
+
+type (
+	DeleteBotInput struct {
+		BotId int64
+	}
+	DeleteBotOutput struct {
+	}
+)
+
+func (uc *DeleteBot) Execute(ctx context.Context, input *DeleteBotInput) (*DeleteBotOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.botRepo.Delete(ctx, input.BotId); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot", input.BotId)
+		}
+		return nil, fmt.Errorf("%w: failed to delete bot", ErrUnexpected)
+	}
+
+	return &DeleteBotOutput{}, nil
+}
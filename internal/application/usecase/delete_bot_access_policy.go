@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type DeleteBotAccessPolicy struct {
+	policyRepo repository.BotAccessPolicyRepositoryPort
+}
+
+func NewDeleteBotAccessPolicy(policyRepo repository.BotAccessPolicyRepositoryPort) (*DeleteBotAccessPolicy, error) {
+	if policyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+
+	return &DeleteBotAccessPolicy{policyRepo: policyRepo}, nil
+}
+
+type (
+	DeleteBotAccessPolicyInput struct {
+		BotId int64
+	}
+	DeleteBotAccessPolicyOutput struct{}
+)
+
+func (uc *DeleteBotAccessPolicy) Execute(ctx context.Context, input *DeleteBotAccessPolicyInput) (*DeleteBotAccessPolicyOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.policyRepo.DeleteByBot(ctx, input.BotId); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot_access_policy", input.BotId)
+		}
+		return nil, fmt.Errorf("%w: failed to delete bot access policy", ErrUnexpected)
+	}
+
+	return &DeleteBotAccessPolicyOutput{}, nil
+}
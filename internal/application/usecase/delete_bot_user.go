@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+// DeleteBotUser removes the user of the bot. Hydra consents and sessions of the user are kept,
+// the user is stored again on the next login.
+type DeleteBotUser struct {
+	botUserRepo repository.BotUserRepositoryPort
+}
+
+func NewDeleteBotUser(botUserRepo repository.BotUserRepositoryPort) (*DeleteBotUser, error) {
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+
+	return &DeleteBotUser{
+		botUserRepo: botUserRepo,
+	}, nil
+}
+
+type (
+	DeleteBotUserInput struct {
+		BotId  int64
+		UserId int64
+	}
+	DeleteBotUserOutput struct {
+	}
+)
+
+func (uc *DeleteBotUser) Execute(ctx context.Context, input *DeleteBotUserInput) (*DeleteBotUserOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.botUserRepo.Delete(ctx, input.BotId, input.UserId); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot_user", input.UserId)
+		}
+		return nil, fmt.Errorf("%w: failed to delete bot user", ErrUnexpected)
+	}
+
+	return &DeleteBotUserOutput{}, nil
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// EraseUserData deletes everything stored about a Telegram user across all bots and revokes
+// the user's consent and login sessions in Hydra. Hydra is revoked first, so that a failed
+// erasure can be retried; erasing a user without any data succeeds.
+type EraseUserData struct {
+	transactor     service.Transactor
+	botUserRepo    repository.BotUserRepositoryPort
+	loginEventRepo repository.LoginEventRepositoryPort
+	hydra          *hydra.APIClient
+}
+
+func NewEraseUserData(
+	transactor service.Transactor,
+	botUserRepo repository.BotUserRepositoryPort,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	hydraClient *hydra.APIClient,
+) (*EraseUserData, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &EraseUserData{
+		transactor:     transactor,
+		botUserRepo:    botUserRepo,
+		loginEventRepo: loginEventRepo,
+		hydra:          hydraClient,
+	}, nil
+}
+
+type (
+	EraseUserDataInput struct {
+		UserId int64
+	}
+	EraseUserDataOutput struct {
+		DeletedBotUsers    int64
+		DeletedLoginEvents int64
+	}
+)
+
+func (uc *EraseUserData) verifyUserId(userId int64) error {
+	if userId <= 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "id", utils.Ptr("must be positive")))
+	}
+	return nil
+}
+
+func (uc *EraseUserData) mapHydraErr(resp *http.Response, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewGatewayTimeoutErr("hydra")
+	}
+	if resp != nil {
+		// Hydra has nothing to revoke
+		if resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return NewBadGatewayErr("hydra")
+		}
+		return ErrUnexpected
+	}
+	return NewBadGatewayErr("hydra")
+}
+
+func (uc *EraseUserData) revokeConsentSessions(ctx context.Context, subject string) error {
+	resp, err := uc.hydra.AdminApi.
+		RevokeConsentSessions(ctx).
+		Subject(subject).
+		All(true).
+		Execute()
+	if err != nil {
+		return uc.mapHydraErr(resp, err)
+	}
+	return nil
+}
+
+func (uc *EraseUserData) revokeLoginSessions(ctx context.Context, subject string) error {
+	resp, err := uc.hydra.AdminApi.
+		RevokeAuthenticationSession(ctx).
+		Subject(subject).
+		Execute()
+	if err != nil {
+		return uc.mapHydraErr(resp, err)
+	}
+	return nil
+}
+
+func (uc *EraseUserData) Execute(ctx context.Context, input *EraseUserDataInput) (*EraseUserDataOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyUserId(input.UserId); err != nil {
+		return nil, err
+	}
+
+	subject := strconv.FormatInt(input.UserId, 10)
+	if err := uc.revokeConsentSessions(ctx, subject); err != nil {
+		return nil, err
+	}
+	if err := uc.revokeLoginSessions(ctx, subject); err != nil {
+		return nil, err
+	}
+
+	var output EraseUserDataOutput
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		deleted, err := uc.botUserRepo.DeleteByUser(ctx, input.UserId)
+		if err != nil {
+			return fmt.Errorf("%w: failed to delete bot users", ErrUnexpected)
+		}
+		output.DeletedBotUsers = deleted
+
+		deleted, err = uc.loginEventRepo.DeleteByUser(ctx, input.UserId)
+		if err != nil {
+			return fmt.Errorf("%w: failed to delete login events", ErrUnexpected)
+		}
+		output.DeletedLoginEvents = deleted
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -98,3 +99,47 @@ func NewGatewayTimeoutErr(service string) error {
 	err.Message = fmt.Sprintf("%s request timed out", service)
 	return err
 }
+
+type TooManyRequestsErr struct {
+	GenericErr
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func NewTooManyRequestsErr(scope string, retryAfter time.Duration) error {
+	err := new(TooManyRequestsErr)
+	err.Scope = scope
+	err.RetryAfter = retryAfter
+	err.Message = fmt.Sprintf("too many requests per %s, retry after %s", scope, retryAfter.Round(time.Second))
+	return err
+}
+
+type AccessDeniedErr struct {
+	GenericErr
+	Reason string
+}
+
+func NewAccessDeniedErr(reason string) error {
+	err := new(AccessDeniedErr)
+	err.Reason = reason
+	err.Message = fmt.Sprintf("access denied: %s", reason)
+	return err
+}
+
+type BotUnavailableErr struct {
+	GenericErr
+	Reason BotUnavailableReason
+	Detail *string // Explanation set by an administrator
+}
+
+func NewBotUnavailableErr(reason BotUnavailableReason, detail *string) error {
+	err := new(BotUnavailableErr)
+	err.Reason = reason
+	if detail == nil {
+		err.Message = fmt.Sprintf("bot is unavailable: %s", reason)
+	} else {
+		err.Message = fmt.Sprintf("bot is unavailable: %s: %s", reason, *detail)
+		err.Detail = detail
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+const consentSessionsPageSize = 500
+
+// ExportUserData collects everything stored about a Telegram user across all bots:
+// the bot users, the login history and the consents granted to OAuth2 clients in Hydra.
+type ExportUserData struct {
+	botUserRepo    repository.BotUserRepositoryPort
+	loginEventRepo repository.LoginEventRepositoryPort
+	hydra          *hydra.APIClient
+}
+
+func NewExportUserData(
+	botUserRepo repository.BotUserRepositoryPort,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	hydraClient *hydra.APIClient,
+) (*ExportUserData, error) {
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &ExportUserData{
+		botUserRepo:    botUserRepo,
+		loginEventRepo: loginEventRepo,
+		hydra:          hydraClient,
+	}, nil
+}
+
+type (
+	ExportUserDataInput struct {
+		UserId int64
+	}
+	ExportUserDataOutput struct {
+		UserId        int64
+		BotUsers      []*entity.BotUser
+		LoginEvents   []*entity.LoginEvent
+		ConsentGrants []*ConsentGrant
+	}
+
+	// ConsentGrant is a consent the user has given to an OAuth2 client.
+	ConsentGrant struct {
+		ClientId        string
+		ClientName      *string
+		GrantedScopes   []string
+		GrantedAudience []string
+		Remember        bool
+		RememberFor     *int64 // Seconds, zero means forever
+		HandledAt       *time.Time
+	}
+)
+
+func (uc *ExportUserData) verifyUserId(userId int64) error {
+	if userId <= 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "id", utils.Ptr("must be positive")))
+	}
+	return nil
+}
+
+func (uc *ExportUserData) listConsentSessions(ctx context.Context, subject string) ([]hydra.PreviousConsentSession, error) {
+	var sessions []hydra.PreviousConsentSession
+	for offset := int64(0); ; offset += consentSessionsPageSize {
+		page, resp, err := uc.hydra.AdminApi.
+			ListSubjectConsentSessions(ctx).
+			Subject(subject).
+			Limit(consentSessionsPageSize).
+			Offset(offset).
+			Execute()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, NewGatewayTimeoutErr("hydra")
+			}
+			if resp != nil {
+				if resp.StatusCode == http.StatusNotFound {
+					return sessions, nil
+				}
+				if resp.StatusCode >= http.StatusInternalServerError {
+					return nil, NewBadGatewayErr("hydra")
+				}
+				return nil, ErrUnexpected
+			}
+			return nil, NewBadGatewayErr("hydra")
+		}
+
+		sessions = append(sessions, page...)
+		if len(page) < consentSessionsPageSize {
+			return sessions, nil
+		}
+	}
+}
+
+func (uc *ExportUserData) buildConsentGrant(session *hydra.PreviousConsentSession) *ConsentGrant {
+	grant := &ConsentGrant{
+		GrantedScopes:   session.GrantScope,
+		GrantedAudience: session.GrantAccessTokenAudience,
+		Remember:        session.GetRemember(),
+		RememberFor:     session.RememberFor,
+		HandledAt:       session.HandledAt,
+	}
+	if session.ConsentRequest != nil && session.ConsentRequest.Client != nil {
+		grant.ClientId = session.ConsentRequest.Client.GetClientId()
+		grant.ClientName = session.ConsentRequest.Client.ClientName
+	}
+	return grant
+}
+
+func (uc *ExportUserData) Execute(ctx context.Context, input *ExportUserDataInput) (*ExportUserDataOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyUserId(input.UserId); err != nil {
+		return nil, err
+	}
+
+	botUsers, err := uc.botUserRepo.GetByUser(ctx, input.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get bot users", ErrUnexpected)
+	}
+
+	loginEvents, err := uc.loginEventRepo.GetByUser(ctx, input.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get login events", ErrUnexpected)
+	}
+
+	sessions, err := uc.listConsentSessions(ctx, strconv.FormatInt(input.UserId, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	output := ExportUserDataOutput{
+		UserId:        input.UserId,
+		BotUsers:      botUsers,
+		LoginEvents:   loginEvents,
+		ConsentGrants: make([]*ConsentGrant, 0, len(sessions)),
+	}
+	for i := range sessions {
+		output.ConsentGrants = append(output.ConsentGrants, uc.buildConsentGrant(&sessions[i]))
+	}
+
+	return &output, nil
+}
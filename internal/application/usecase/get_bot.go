@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type GetBot struct {
+	botRepo             repository.BotRepositoryPort
+	baseUri             *url.URL
+	telegramDeepLinkUri *url.URL
+}
+
+func NewGetBot(
+	botRepo repository.BotRepositoryPort,
+	baseUri *url.URL,
+	telegramDeepLinkUri *url.URL,
+) (*GetBot, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if baseUri == nil {
+		return nil, errors.New("base uri is nil")
+	}
+	if telegramDeepLinkUri == nil {
+		return nil, errors.New("telegram deep link uri is nil")
+	}
+
+	return &GetBot{
+		botRepo:             botRepo,
+		baseUri:             baseUri,
+		telegramDeepLinkUri: telegramDeepLinkUri,
+	}, nil
+}
+
+type (
+	GetBotInput struct {
+		BotId int64
+	}
+	GetBotOutput struct {
+		Bot      *entity.Bot
+		Url      string
+		PhotoUrl *string // Nil when the bot has no profile photo
+	}
+)
+
+func (uc *GetBot) Execute(ctx context.Context, input *GetBotInput) (*GetBotOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, input.BotId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot", input.BotId)
+		}
+		return nil, fmt.Errorf("%w: failed to get bot by ID", ErrUnexpected)
+	}
+
+	return &GetBotOutput{
+		Bot:      &bot,
+		Url:      uc.telegramDeepLinkUri.JoinPath(bot.Username).String(),
+		PhotoUrl: buildBotPhotoUri(uc.baseUri, &bot),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type GetBotAccessPolicy struct {
+	botRepo    repository.BotRepositoryPort
+	policyRepo repository.BotAccessPolicyRepositoryPort
+}
+
+func NewGetBotAccessPolicy(
+	botRepo repository.BotRepositoryPort,
+	policyRepo repository.BotAccessPolicyRepositoryPort,
+) (*GetBotAccessPolicy, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if policyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+
+	return &GetBotAccessPolicy{
+		botRepo:    botRepo,
+		policyRepo: policyRepo,
+	}, nil
+}
+
+type (
+	GetBotAccessPolicyInput struct {
+		BotId int64
+	}
+	GetBotAccessPolicyOutput struct {
+		Policy *entity.BotAccessPolicy
+	}
+)
+
+func (uc *GetBotAccessPolicy) Execute(ctx context.Context, input *GetBotAccessPolicyInput) (*GetBotAccessPolicyOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+	}
+	if !exists {
+		return nil, NewObjectNotFoundErr("bot", input.BotId)
+	}
+
+	var policy entity.BotAccessPolicy
+	if err := uc.policyRepo.GetByBot(ctx, input.BotId, &policy); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot_access_policy", input.BotId)
+		}
+		return nil, fmt.Errorf("%w: failed to get bot access policy", ErrUnexpected)
+	}
+
+	return &GetBotAccessPolicyOutput{Policy: &policy}, nil
+}
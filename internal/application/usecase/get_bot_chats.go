@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type GetBotChats struct {
+	botRepo  repository.BotRepositoryPort
+	chatRepo repository.BotChatRepositoryPort
+}
+
+func NewGetBotChats(
+	botRepo repository.BotRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+) (*GetBotChats, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+
+	return &GetBotChats{
+		botRepo:  botRepo,
+		chatRepo: chatRepo,
+	}, nil
+}
+
+type (
+	GetBotChatsInput struct {
+		BotId int64
+	}
+	GetBotChatsOutput struct {
+		Chats []*entity.BotChat
+	}
+)
+
+func (uc *GetBotChats) Execute(ctx context.Context, input *GetBotChatsInput) (*GetBotChatsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+	}
+	if !exists {
+		return nil, NewObjectNotFoundErr("bot", input.BotId)
+	}
+
+	chats, err := uc.chatRepo.GetByBot(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get bot chats", ErrUnexpected)
+	}
+
+	return &GetBotChatsOutput{Chats: chats}, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type GetBotClaimMappings struct {
+	botRepo     repository.BotRepositoryPort
+	mappingRepo repository.BotClaimMappingRepositoryPort
+}
+
+func NewGetBotClaimMappings(
+	botRepo repository.BotRepositoryPort,
+	mappingRepo repository.BotClaimMappingRepositoryPort,
+) (*GetBotClaimMappings, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if mappingRepo == nil {
+		return nil, errors.New("bot claim mapping repository is nil")
+	}
+
+	return &GetBotClaimMappings{
+		botRepo:     botRepo,
+		mappingRepo: mappingRepo,
+	}, nil
+}
+
+type (
+	GetBotClaimMappingsInput struct {
+		BotId int64
+	}
+	GetBotClaimMappingsOutput struct {
+		Mappings []*entity.BotClaimMapping
+	}
+)
+
+func (uc *GetBotClaimMappings) Execute(ctx context.Context, input *GetBotClaimMappingsInput) (*GetBotClaimMappingsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+	}
+	if !exists {
+		return nil, NewObjectNotFoundErr("bot", input.BotId)
+	}
+
+	mappings, err := uc.mappingRepo.GetByBot(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get bot claim mappings", ErrUnexpected)
+	}
+
+	return &GetBotClaimMappingsOutput{Mappings: mappings}, nil
+}
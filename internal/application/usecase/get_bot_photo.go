@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// buildBotPhotoUri returns the public URI of the bot profile photo, nil when the bot has none.
+// The photo id is part of the query, so that the URI changes along with the photo and can be cached.
+func buildBotPhotoUri(baseUri *url.URL, bot *entity.Bot) *string {
+	if bot.PhotoFileUniqueId == nil {
+		return nil
+	}
+	photoUri := *baseUri.JoinPath("/bot-photos", strconv.FormatInt(bot.Id, 10))
+	photoUriQuery := photoUri.Query()
+	photoUriQuery.Set("v", *bot.PhotoFileUniqueId)
+	photoUri.RawQuery = photoUriQuery.Encode()
+	return utils.Ptr(photoUri.String())
+}
+
+type GetBotPhoto struct {
+	botPhotoRepo repository.BotPhotoRepositoryPort
+}
+
+func NewGetBotPhoto(botPhotoRepo repository.BotPhotoRepositoryPort) (*GetBotPhoto, error) {
+	if botPhotoRepo == nil {
+		return nil, errors.New("bot photo repository is nil")
+	}
+
+	return &GetBotPhoto{
+		botPhotoRepo: botPhotoRepo,
+	}, nil
+}
+
+type (
+	GetBotPhotoInput struct {
+		BotId int64
+	}
+	GetBotPhotoOutput struct {
+		Photo *entity.BotPhoto
+	}
+)
+
+func (uc *GetBotPhoto) Execute(ctx context.Context, input *GetBotPhotoInput) (*GetBotPhotoOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var photo entity.BotPhoto
+	if err := uc.botPhotoRepo.GetByBot(ctx, input.BotId, &photo); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot_photo", input.BotId)
+		}
+		return nil, fmt.Errorf("%w: failed to get bot photo", ErrUnexpected)
+	}
+
+	return &GetBotPhotoOutput{Photo: &photo}, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type GetBotUser struct {
+	botUserRepo repository.BotUserRepositoryPort
+}
+
+func NewGetBotUser(botUserRepo repository.BotUserRepositoryPort) (*GetBotUser, error) {
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+
+	return &GetBotUser{
+		botUserRepo: botUserRepo,
+	}, nil
+}
+
+type (
+	GetBotUserInput struct {
+		BotId  int64
+		UserId int64
+	}
+	GetBotUserOutput struct {
+		User *entity.BotUser
+	}
+)
+
+func (uc *GetBotUser) Execute(ctx context.Context, input *GetBotUserInput) (*GetBotUserOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var botUser entity.BotUser
+	if err := uc.botUserRepo.GetByBotAndUser(ctx, input.BotId, input.UserId, &botUser); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("bot_user", input.UserId)
+		}
+		return nil, fmt.Errorf("%w: failed to get bot user", ErrUnexpected)
+	}
+
+	return &GetBotUserOutput{User: &botUser}, nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	hydra "github.com/ory/hydra-client-go"
+)
+
+func getConsentRequest(ctx context.Context, hydraClient *hydra.APIClient, consentChallenge string) (*hydra.ConsentRequest, error) {
+	consentRequest, resp, err := hydraClient.AdminApi.
+		GetConsentRequest(ctx).
+		ConsentChallenge(consentChallenge).
+		Execute()
+	if err != nil {
+		return nil, mapHydraChallengeError(err, resp, "consent")
+	}
+	if consentRequest == nil || consentRequest.Client == nil || consentRequest.Client.ClientId == nil {
+		return nil, ErrUnexpected
+	}
+
+	return consentRequest, nil
+}
+
+// rejectConsentRequest rejects the consent request with the OAuth2 error reason maps to
+// and returns the URI the browser must be redirected to.
+func rejectConsentRequest(ctx context.Context, hydraClient *hydra.APIClient, consentChallenge string, reason error) (string, error) {
+	reasonDebug := "unknown"
+	if reason != nil {
+		reasonDebug = reason.Error()
+	}
+
+	oauth2Error, statusCode, description := mapConsentRejectError(reason)
+	rejectReq := hydra.NewRejectRequest()
+	rejectReq.SetError(oauth2Error)
+	rejectReq.SetStatusCode(statusCode)
+	rejectReq.SetErrorDescription(description)
+	rejectReq.SetErrorHint("consent request was rejected")
+	rejectReq.SetErrorDebug(reasonDebug)
+
+	completed, resp, err := hydraClient.AdminApi.
+		RejectConsentRequest(ctx).
+		ConsentChallenge(consentChallenge).
+		RejectRequest(*rejectReq).
+		Execute()
+	if err != nil {
+		return "", mapHydraChallengeError(err, resp, "")
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return "", ErrUnexpected
+	}
+
+	return completed.RedirectTo, nil
+}
+
+// mapConsentRejectError maps a consent failure to the OAuth2 error, status code and description
+// Hydra returns to the client.
+func mapConsentRejectError(err error) (string, int64, string) {
+	if err == nil {
+		return "server_error", http.StatusInternalServerError, "unexpected consent error"
+	}
+
+	var gatewayTimeoutErr *GatewayTimeoutErr
+	if errors.As(err, &gatewayTimeoutErr) {
+		return "temporarily_unavailable", http.StatusServiceUnavailable, "authorization service is temporarily unavailable"
+	}
+
+	var badGatewayErr *BadGatewayErr
+	if errors.As(err, &badGatewayErr) {
+		return "temporarily_unavailable", http.StatusServiceUnavailable, "authorization service is temporarily unavailable"
+	}
+
+	var objectInvalidErr *ObjectInvalidErr
+	if errors.As(err, &objectInvalidErr) {
+		if objectInvalidErr.Object == "consent" && objectInvalidErr.Field == "challenge" {
+			return "invalid_request", http.StatusBadRequest, "invalid consent challenge"
+		}
+		return "invalid_request", http.StatusBadRequest, "invalid consent request"
+	}
+
+	var objectNotFoundErr *ObjectNotFoundErr
+	if errors.As(err, &objectNotFoundErr) {
+		if objectNotFoundErr.Object == "client" {
+			return "unauthorized_client", http.StatusBadRequest, "oauth2 client is not linked to bot configuration"
+		}
+		return "access_denied", http.StatusForbidden, "consent cannot be granted"
+	}
+
+	if errors.Is(err, ErrInvalidInput) {
+		return "invalid_request", http.StatusBadRequest, "invalid consent request"
+	}
+
+	return "server_error", http.StatusInternalServerError, "internal consent error"
+}
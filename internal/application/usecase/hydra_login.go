@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	hydra "github.com/ory/hydra-client-go"
+)
+
+// mapHydraChallengeError maps a failed Hydra admin call on a login or consent challenge.
+// Hydra answers 404 and 400 for unknown, expired and already handled challenges.
+func mapHydraChallengeError(err error, resp *http.Response, object string) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewGatewayTimeoutErr("hydra")
+	}
+	if resp != nil {
+		if object != "" && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest) {
+			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr(object, "challenge", nil))
+		}
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return NewBadGatewayErr("hydra")
+		}
+		return ErrUnexpected
+	}
+	return NewBadGatewayErr("hydra")
+}
+
+// isInvalidLoginChallenge reports whether err means that Hydra does not know the login challenge.
+func isInvalidLoginChallenge(err error) bool {
+	var objectInvalidErr *ObjectInvalidErr
+	return errors.As(err, &objectInvalidErr) && objectInvalidErr.Object == "login" && objectInvalidErr.Field == "challenge"
+}
+
+func getLoginRequest(ctx context.Context, hydraClient *hydra.APIClient, loginChallenge string) (*hydra.LoginRequest, error) {
+	loginRequest, resp, err := hydraClient.AdminApi.
+		GetLoginRequest(ctx).
+		LoginChallenge(loginChallenge).
+		Execute()
+	if err != nil {
+		return nil, mapHydraChallengeError(err, resp, "login")
+	}
+	if loginRequest == nil || loginRequest.Client.ClientId == nil {
+		return nil, ErrUnexpected
+	}
+
+	return loginRequest, nil
+}
+
+// acceptLoginRequest authenticates the Telegram user as the subject of the login request
+// and returns the URI the browser must be redirected to.
+func acceptLoginRequest(ctx context.Context, hydraClient *hydra.APIClient, loginChallenge string, userId int64) (string, error) {
+	acceptReq := hydra.NewAcceptLoginRequest(strconv.FormatInt(userId, 10))
+
+	completed, resp, err := hydraClient.AdminApi.
+		AcceptLoginRequest(ctx).
+		LoginChallenge(loginChallenge).
+		AcceptLoginRequest(*acceptReq).
+		Execute()
+	if err != nil {
+		return "", mapHydraChallengeError(err, resp, "login")
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return "", ErrUnexpected
+	}
+
+	return completed.RedirectTo, nil
+}
+
+// rejectLoginRequest rejects the login request with the OAuth2 error reason maps to
+// and returns the URI the browser must be redirected to.
+func rejectLoginRequest(ctx context.Context, hydraClient *hydra.APIClient, loginChallenge string, reason error) (string, error) {
+	reasonDebug := "unknown"
+	if reason != nil {
+		reasonDebug = reason.Error()
+	}
+
+	oauth2Error, statusCode, description := mapLoginRejectError(reason)
+	rejectReq := hydra.NewRejectRequest()
+	rejectReq.SetError(oauth2Error)
+	rejectReq.SetStatusCode(statusCode)
+	rejectReq.SetErrorDescription(description)
+	rejectReq.SetErrorHint("authentication request was rejected")
+	rejectReq.SetErrorDebug(reasonDebug)
+
+	completed, resp, err := hydraClient.AdminApi.
+		RejectLoginRequest(ctx).
+		LoginChallenge(loginChallenge).
+		RejectRequest(*rejectReq).
+		Execute()
+	if err != nil {
+		return "", mapHydraChallengeError(err, resp, "")
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return "", ErrUnexpected
+	}
+
+	return completed.RedirectTo, nil
+}
+
+// mapLoginRejectError maps a login failure to the OAuth2 error, status code and description
+// Hydra returns to the client. The error code is also recorded in login events.
+func mapLoginRejectError(err error) (string, int64, string) {
+	if err == nil {
+		return "server_error", http.StatusInternalServerError, "unexpected authentication error"
+	}
+
+	var botUnavailableErr *BotUnavailableErr
+	if errors.As(err, &botUnavailableErr) {
+		return mapBotUnavailableError(botUnavailableErr)
+	}
+
+	var accessDeniedErr *AccessDeniedErr
+	if errors.As(err, &accessDeniedErr) {
+		return "access_denied", http.StatusForbidden, "user is not allowed to sign in to this application"
+	}
+
+	var tooManyRequestsErr *TooManyRequestsErr
+	if errors.As(err, &tooManyRequestsErr) {
+		return "temporarily_unavailable", http.StatusTooManyRequests, "too many login attempts, try again later"
+	}
+
+	var gatewayTimeoutErr *GatewayTimeoutErr
+	if errors.As(err, &gatewayTimeoutErr) {
+		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
+	}
+
+	var badGatewayErr *BadGatewayErr
+	if errors.As(err, &badGatewayErr) {
+		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
+	}
+
+	var objectInvalidErr *ObjectInvalidErr
+	if errors.As(err, &objectInvalidErr) {
+		if objectInvalidErr.Object == "telegram_auth_data" && objectInvalidErr.Field == "hash" &&
+			objectInvalidErr.Reason != nil && *objectInvalidErr.Reason == "replay" {
+			return "access_denied", http.StatusForbidden, "authentication data has already been used"
+		}
+		if objectInvalidErr.Object == "bot" && objectInvalidErr.Field == "token" {
+			return "unauthorized_client", http.StatusBadRequest, "client is linked to invalid bot credentials"
+		}
+		if objectInvalidErr.Object == "login" && objectInvalidErr.Field == "nonce" {
+			return "access_denied", http.StatusForbidden, "bot login link is invalid or has expired"
+		}
+		if objectInvalidErr.Object == "login" && objectInvalidErr.Field == "challenge" {
+			return "invalid_request", http.StatusBadRequest, "invalid login challenge"
+		}
+		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
+	}
+
+	var objectNotFoundErr *ObjectNotFoundErr
+	if errors.As(err, &objectNotFoundErr) {
+		if objectNotFoundErr.Object == "client" {
+			return "unauthorized_client", http.StatusBadRequest, "oauth2 client is not linked to bot configuration"
+		}
+		return "access_denied", http.StatusForbidden, "authentication cannot be completed"
+	}
+
+	if errors.Is(err, ErrInvalidInput) {
+		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
+	}
+
+	return "server_error", http.StatusInternalServerError, "internal authentication error"
+}
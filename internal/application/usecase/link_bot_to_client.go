@@ -1,61 +1,164 @@
 package usecase
 
-// import (
-// 	"context"
-// 	"errors"
-// 	"fmt"
-
-// 	hydraclient "github.com/ory/hydra-client-go"
-// 	"github.com/rs/zerolog"
-// 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
-// )
-
-// type LinkBotToClient struct {
-// 	botRepo repository.BotRepositoryPort
-// 	hydra   *hydraclient.APIClient
-// }
-
-// type (
-// 	LinkBotToClientInput struct {
-// 		BotId    int64
-// 		ClientID string
-// 	}
-// 	LinkBotToClientOutput struct {
-// 	}
-// )
-
-// func (uc *LinkBotToClient) checkClientExists(ctx context.Context, clientId string) error {
-// 	client, resp, err := uc.hydra.AdminApi.GetOAuth2Client(ctx, clientId).Execute()
-// 	if err != nil {
-// 		if errors.Is(err, context.DeadlineExceeded) {
-// 			return NewGatewayTimeoutErr("hydra")
-// 		}
-// 		if resp == nil {
-// 			return NewBadGatewayErr("hydra")
-// 		}
-// 		if resp.StatusCode == 404 {
-// 			return NewObjectNotFoundErr("client", clientId)
-// 		}
-// 		if resp.StatusCode >= 500 && resp.StatusCode < 600 {
-// 			return NewBadGatewayErr("hydra")
-// 		}
-// 		return fmt.Errorf("%w: failed to check client existence", ErrUnexpected)
-// 	}
-// 	zerolog.
-// 		Ctx(ctx).
-// 		Debug().
-// 		Str("client_id", *client.ClientId).
-// 		Msg("client found in hydra")
-// 	return nil
-// }
-
-// func (uc *LinkBotToClient) Execute(ctx context.Context, input *LinkBotToClientInput) (*LinkBotToClientOutput, error) {
-// 	if input == nil {
-// 		return nil, errors.New("input is nil")
-// 	}
-
-// 	if err := uc.checkClientExists(ctx, input.ClientID); err != nil {
-// 		return nil, err
-// 	}
-
-// }
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	hydraclient "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type LinkBotToClient struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+	hydra      *hydraclient.APIClient
+}
+
+func NewLinkBotToClient(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+	hydraClient *hydraclient.APIClient,
+) (*LinkBotToClient, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &LinkBotToClient{
+		transactor: transactor,
+		botRepo:    botRepo,
+		hydra:      hydraClient,
+	}, nil
+}
+
+type (
+	LinkBotToClientInput struct {
+		BotId    int64
+		ClientID string
+	}
+	LinkBotToClientOutput struct {
+		BotId    int64
+		ClientID string
+		Updated  bool
+	}
+)
+
+func (uc *LinkBotToClient) verifyClientId(clientId string) error {
+	if clientId == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", "id", utils.Ptr("empty")))
+	}
+	return nil
+}
+
+func (uc *LinkBotToClient) checkClientExists(ctx context.Context, clientId string) error {
+	client, resp, err := uc.hydra.AdminApi.GetOAuth2Client(ctx, clientId).Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return NewGatewayTimeoutErr("hydra")
+		}
+		if resp == nil {
+			return NewBadGatewayErr("hydra")
+		}
+		if resp.StatusCode == 404 {
+			return NewObjectNotFoundErr("client", clientId)
+		}
+		if resp.StatusCode >= 500 && resp.StatusCode < 600 {
+			return NewBadGatewayErr("hydra")
+		}
+		return fmt.Errorf("%w: failed to check client existence", ErrUnexpected)
+	}
+	zerolog.
+		Ctx(ctx).
+		Debug().
+		Str("client_id", client.GetClientId()).
+		Msg("client found in hydra")
+	return nil
+}
+
+func (uc *LinkBotToClient) checkClientNotLinked(ctx context.Context, botId int64, clientId string) error {
+	var linkedBot entity.Bot
+	if err := uc.botRepo.GetByClientID(ctx, clientId, &linkedBot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("%w: failed to get bot by client ID", ErrUnexpected)
+	}
+	if linkedBot.Id != botId {
+		return NewConflictErr("bot", utils.Ptr("client_id"))
+	}
+	return nil
+}
+
+func (uc *LinkBotToClient) linkBot(ctx context.Context, botId int64, clientId string) (bool, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, botId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, NewObjectNotFoundErr("bot", botId)
+		}
+		return false, fmt.Errorf("%w: failed to get bot by ID", ErrUnexpected)
+	}
+
+	if err := uc.checkClientNotLinked(ctx, botId, clientId); err != nil {
+		return false, err
+	}
+
+	beforeTouch := bot.ModifiedAt()
+	if err := bot.SetClientId(utils.Ptr(clientId)); err != nil {
+		return false, fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("client", "id", nil), err)
+	}
+	if !bot.ModifiedAt().After(beforeTouch) {
+		return false, nil
+	}
+
+	if err := uc.botRepo.Update(ctx, &bot); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) {
+			return false, NewConflictErr("bot", nil)
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return false, NewConflictErr("bot", utils.Ptr("client_id"))
+		}
+		return false, fmt.Errorf("%w: failed to update bot", ErrUnexpected)
+	}
+	return true, nil
+}
+
+func (uc *LinkBotToClient) Execute(ctx context.Context, input *LinkBotToClientInput) (*LinkBotToClientOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyClientId(input.ClientID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkClientExists(ctx, input.ClientID); err != nil {
+		return nil, err
+	}
+
+	output := LinkBotToClientOutput{
+		BotId:    input.BotId,
+		ClientID: input.ClientID,
+	}
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		updated, err := uc.linkBot(ctx, input.BotId, input.ClientID)
+		if err != nil {
+			return err
+		}
+		output.Updated = updated
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
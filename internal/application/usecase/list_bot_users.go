@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type ListBotUsers struct {
+	botRepo     repository.BotRepositoryPort
+	botUserRepo repository.BotUserRepositoryPort
+}
+
+func NewListBotUsers(
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+) (*ListBotUsers, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+
+	return &ListBotUsers{
+		botRepo:     botRepo,
+		botUserRepo: botUserRepo,
+	}, nil
+}
+
+type (
+	ListBotUsersInput struct {
+		BotId     int64
+		Search    *string // Matches username (with or without a leading @), first or last name
+		Ascending bool    // Orders users from the oldest last login
+		Cursor    *string
+		Limit     *int
+	}
+	ListBotUsersOutput struct {
+		Users      []*entity.BotUser
+		NextCursor *string // Nil when there are no more users
+	}
+)
+
+func (uc *ListBotUsers) buildFilter(input *ListBotUsersInput) (*repository.BotUserListFilter, error) {
+	limit, err := normalizePageLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.BotUserListFilter{
+		BotId:     input.BotId,
+		Ascending: input.Ascending,
+		// Fetch one extra user to find out whether there is a next page
+		Limit: limit + 1,
+	}
+	if input.Search != nil {
+		if search := strings.TrimPrefix(strings.TrimSpace(*input.Search), "@"); search != "" {
+			filter.Search = utils.Ptr(search)
+		}
+	}
+	if input.Cursor != nil && *input.Cursor != "" {
+		after, err := decodeBotUserCursor(*input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+
+	return &filter, nil
+}
+
+// decodeBotUserCursor restores the last login and user ID encoded by encodeBotUserCursor.
+func decodeBotUserCursor(cursor string) (*repository.BotUserListKey, error) {
+	parts, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+	lastLoginAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "cursor", utils.Ptr("malformed")))
+	}
+	userId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("page", "cursor", utils.Ptr("malformed")))
+	}
+	return &repository.BotUserListKey{LastLoginAt: lastLoginAt, UserId: userId}, nil
+}
+
+func encodeBotUserCursor(botUser *entity.BotUser) string {
+	return encodeCursor(botUser.LastLoginAt.Format(time.RFC3339Nano), strconv.FormatInt(botUser.UserId, 10))
+}
+
+func (uc *ListBotUsers) Execute(ctx context.Context, input *ListBotUsersInput) (*ListBotUsersOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	filter, err := uc.buildFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+	}
+	if !exists {
+		return nil, NewObjectNotFoundErr("bot", input.BotId)
+	}
+
+	users, err := uc.botUserRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list bot users", ErrUnexpected)
+	}
+
+	output := ListBotUsersOutput{Users: users}
+	if limit := filter.Limit - 1; len(users) > limit {
+		output.Users = users[:limit]
+		output.NextCursor = utils.Ptr(encodeBotUserCursor(output.Users[limit-1]))
+	}
+
+	return &output, nil
+}
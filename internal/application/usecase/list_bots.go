@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type ListBots struct {
+	botRepo repository.BotRepositoryPort
+}
+
+func NewListBots(botRepo repository.BotRepositoryPort) (*ListBots, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+
+	return &ListBots{
+		botRepo: botRepo,
+	}, nil
+}
+
+type (
+	ListBotsInput struct {
+		Linked         *bool
+		UsernamePrefix *string
+		Cursor         *string
+		Limit          *int
+	}
+	ListBotsOutput struct {
+		Bots       []*entity.Bot
+		NextCursor *string // Nil when there are no more bots
+	}
+)
+
+func (uc *ListBots) buildFilter(input *ListBotsInput) (*repository.BotListFilter, error) {
+	limit, err := normalizePageLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.BotListFilter{
+		Linked:         input.Linked,
+		UsernamePrefix: input.UsernamePrefix,
+		// Fetch one extra bot to find out whether there is a next page
+		Limit: limit + 1,
+	}
+	if input.Cursor != nil && *input.Cursor != "" {
+		afterId, err := decodeIdCursor(*input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterId = utils.Ptr(afterId)
+	}
+
+	return &filter, nil
+}
+
+func (uc *ListBots) Execute(ctx context.Context, input *ListBotsInput) (*ListBotsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	filter, err := uc.buildFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	bots, err := uc.botRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list bots", ErrUnexpected)
+	}
+
+	output := ListBotsOutput{Bots: bots}
+	if limit := filter.Limit - 1; len(bots) > limit {
+		output.Bots = bots[:limit]
+		output.NextCursor = utils.Ptr(encodeCursor(strconv.FormatInt(output.Bots[limit-1].Id, 10)))
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type ListLoginEvents struct {
+	loginEventRepo repository.LoginEventRepositoryPort
+}
+
+func NewListLoginEvents(loginEventRepo repository.LoginEventRepositoryPort) (*ListLoginEvents, error) {
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+
+	return &ListLoginEvents{
+		loginEventRepo: loginEventRepo,
+	}, nil
+}
+
+type (
+	ListLoginEventsInput struct {
+		BotId    *int64
+		UserId   *int64
+		ClientId *string
+		Outcome  *entity.LoginOutcome
+		Since    *time.Time
+		Until    *time.Time
+		Cursor   *string
+		Limit    *int
+	}
+	ListLoginEventsOutput struct {
+		Events     []*entity.LoginEvent
+		NextCursor *string // Nil when there are no more events
+	}
+)
+
+func (uc *ListLoginEvents) buildFilter(input *ListLoginEventsInput) (*repository.LoginEventListFilter, error) {
+	limit, err := normalizePageLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if input.Since != nil && input.Until != nil && !input.Since.Before(*input.Until) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login_event", "since", utils.Ptr("must be before until")))
+	}
+
+	filter := repository.LoginEventListFilter{
+		BotId:    input.BotId,
+		UserId:   input.UserId,
+		ClientId: input.ClientId,
+		Outcome:  input.Outcome,
+		Since:    input.Since,
+		Until:    input.Until,
+		// Fetch one extra event to find out whether there is a next page
+		Limit: limit + 1,
+	}
+	if input.Cursor != nil && *input.Cursor != "" {
+		beforeId, err := decodeIdCursor(*input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.BeforeId = utils.Ptr(beforeId)
+	}
+
+	return &filter, nil
+}
+
+func (uc *ListLoginEvents) Execute(ctx context.Context, input *ListLoginEventsInput) (*ListLoginEventsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	filter, err := uc.buildFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.loginEventRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list login events", ErrUnexpected)
+	}
+
+	output := ListLoginEventsOutput{Events: events}
+	if limit := filter.Limit - 1; len(events) > limit {
+		output.Events = events[:limit]
+		output.NextCursor = utils.Ptr(encodeCursor(strconv.FormatInt(output.Events[limit-1].Id, 10)))
+	}
+
+	return &output, nil
+}
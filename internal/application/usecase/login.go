@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// Checks shared by the login flows, so that every flow applies the same rules.
+
+func verifyLoginChallenge(loginChallenge string) error {
+	if loginChallenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
+	}
+
+	return nil
+}
+
+func verifyClientIP(clientIP netip.Addr) error {
+	if !clientIP.IsValid() || clientIP.IsUnspecified() {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("request", "client_ip", nil))
+	}
+
+	return nil
+}
+
+// getClientBot loads the bot linked to the OAuth2 client of a login request.
+func getClientBot(ctx context.Context, botRepo repository.BotRepositoryPort, clientId string) (*entity.Bot, error) {
+	var bot entity.Bot
+	if err := botRepo.GetByClientID(ctx, clientId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
+		}
+		return nil, ErrUnexpected
+	}
+	return &bot, nil
+}
+
+// getLinkedBot loads a bot by id for logins started in Telegram. The bot must be linked to a client.
+func getLinkedBot(ctx context.Context, botRepo repository.BotRepositoryPort, botId int64) (*entity.Bot, error) {
+	var bot entity.Bot
+	if err := botRepo.GetByID(ctx, botId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("bot", botId))
+		}
+		return nil, ErrUnexpected
+	}
+	if bot.ClientId == nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", nil))
+	}
+	return &bot, nil
+}
+
+// getVerifiedBotToken loads the token of the bot and checks that Telegram still accepts it.
+func getVerifiedBotToken(ctx context.Context, tokenVerifier service.TelegramTokenVerifier, bot *entity.Bot) (string, error) {
+	botToken, err := getBotToken(ctx, bot)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := tokenVerifier.Verify(ctx, botToken, nil); err != nil {
+		if errors.Is(err, service.ErrTelegramBotTokenMalformed) {
+			return "", fmt.Errorf(
+				"%w: %w",
+				ErrInvalidInput,
+				NewObjectInvalidErr("bot", "token", utils.Ptr("malformed")))
+		}
+		if errors.Is(err, service.ErrTelegramBotTokenInvalid) {
+			return "", fmt.Errorf(
+				"%w: %w",
+				ErrInvalidInput,
+				NewObjectInvalidErr("bot", "token", nil))
+		}
+		return "", ErrUnexpected
+	}
+	return botToken, nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type LoginByBot struct {
+	transactor     service.Transactor
+	hydra          *hydra.APIClient
+	nonceStore     service.TelegramLoginNonceStore
+	botRepo        repository.BotRepositoryPort
+	botUserRepo    repository.BotUserRepositoryPort
+	accessPolicy   *accessPolicyChecker
+	loginEventRepo repository.LoginEventRepositoryPort
+}
+
+func NewLoginByBot(
+	transactor service.Transactor,
+	hydraClient *hydra.APIClient,
+	nonceStore service.TelegramLoginNonceStore,
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+	accessPolicyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+	loginEventRepo repository.LoginEventRepositoryPort,
+) (*LoginByBot, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if nonceStore == nil {
+		return nil, errors.New("login nonce store is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if accessPolicyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+
+	return &LoginByBot{
+		transactor:     transactor,
+		hydra:          hydraClient,
+		nonceStore:     nonceStore,
+		botRepo:        botRepo,
+		botUserRepo:    botUserRepo,
+		accessPolicy:   newAccessPolicyChecker(accessPolicyRepo, chatRepo, chatMemberChecker),
+		loginEventRepo: loginEventRepo,
+	}, nil
+}
+
+type (
+	LoginByBotInput struct {
+		LoginChallenge string
+		Nonce          string
+		UserAgent      *string
+		Language       *string
+		ClientIP       netip.Addr
+	}
+	LoginByBotOutput struct {
+		// Pending is true while the user has not yet confirmed the login in the bot.
+		Pending     bool
+		RedirectUri string
+	}
+)
+
+func (uc *LoginByBot) verifyNonce(nonce string) error {
+	if nonce == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", nil))
+	}
+
+	return nil
+}
+
+func (uc *LoginByBot) getNonce(ctx context.Context, loginChallenge string, nonce string) (*service.TelegramLoginNonce, error) {
+	loginNonce, err := uc.nonceStore.Get(ctx, nonce)
+	if err != nil {
+		if errors.Is(err, service.ErrLoginNonceNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", utils.Ptr("expired")))
+		}
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("login_challenge", loginChallenge).
+			Msg("failed to load bot login nonce")
+		return nil, NewBadGatewayErr("telegram_login_nonce_store")
+	}
+	if loginNonce.LoginChallenge != loginChallenge {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", nil))
+	}
+
+	return loginNonce, nil
+}
+
+// consumeNonce removes the confirmed nonce, so that concurrent polls cannot both complete the login.
+// Fails when another request has already consumed it.
+func (uc *LoginByBot) consumeNonce(ctx context.Context, loginChallenge string, nonce string) (*service.TelegramLoginNonce, error) {
+	loginNonce, err := uc.nonceStore.Consume(ctx, nonce)
+	if err != nil {
+		if errors.Is(err, service.ErrLoginNonceNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", utils.Ptr("consumed")))
+		}
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("login_challenge", loginChallenge).
+			Msg("failed to consume bot login nonce")
+		return nil, NewBadGatewayErr("telegram_login_nonce_store")
+	}
+	if loginNonce.LoginChallenge != loginChallenge || !loginNonce.IsConfirmed() {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", nil))
+	}
+
+	return loginNonce, nil
+}
+
+func (uc *LoginByBot) verifyNonceBot(loginNonce *service.TelegramLoginNonce, bot *entity.Bot) error {
+	if loginNonce.BotId != bot.Id {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "nonce", utils.Ptr("bot_mismatch")))
+	}
+
+	return nil
+}
+
+func (uc *LoginByBot) rejectAndBuildOutput(
+	ctx context.Context,
+	loginChallenge string,
+	attempt *loginAttempt,
+	reason error,
+) (*LoginByBotOutput, error) {
+	recordLoginRejection(ctx, uc.loginEventRepo, attempt, reason)
+
+	redirectUri, rejectErr := rejectLoginRequest(ctx, uc.hydra, loginChallenge, reason)
+	if rejectErr != nil {
+		return nil, rejectErr
+	}
+
+	return &LoginByBotOutput{RedirectUri: redirectUri}, nil
+}
+
+func (uc *LoginByBot) Execute(ctx context.Context, input *LoginByBotInput) (*LoginByBotOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := verifyLoginChallenge(input.LoginChallenge); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyNonce(input.Nonce); err != nil {
+		return nil, err
+	}
+
+	if err := verifyClientIP(input.ClientIP); err != nil {
+		return nil, err
+	}
+
+	attempt := &loginAttempt{
+		method:    entity.LoginMethodBot,
+		ip:        input.ClientIP,
+		userAgent: input.UserAgent,
+	}
+
+	loginNonce, err := uc.getNonce(ctx, input.LoginChallenge, input.Nonce)
+	if err != nil {
+		var objectInvalidErr *ObjectInvalidErr
+		if errors.As(err, &objectInvalidErr) && objectInvalidErr.Reason != nil && *objectInvalidErr.Reason == "expired" {
+			return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+		}
+		return nil, err
+	}
+
+	if !loginNonce.IsConfirmed() {
+		return &LoginByBotOutput{Pending: true}, nil
+	}
+
+	// The nonce is single-use: take it before any check, so it cannot complete another login.
+	// A poll that loses the race fails and leaves the login to the one that consumed it.
+	loginNonce, err = uc.consumeNonce(ctx, input.LoginChallenge, input.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	attempt.userId = utils.Ptr(loginNonce.User.Id)
+
+	loginRequest, err := getLoginRequest(ctx, uc.hydra, input.LoginChallenge)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	clientId := *loginRequest.Client.ClientId
+	attempt.clientId = utils.Ptr(clientId)
+
+	bot, err := getClientBot(ctx, uc.botRepo, clientId)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+	attempt.botId = utils.Ptr(bot.Id)
+
+	if err := checkBotAvailability(bot); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	if err := uc.verifyNonceBot(loginNonce, bot); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	language := input.Language
+	if language == nil {
+		language = loginNonce.User.LanguageCode
+	}
+
+	if err := uc.accessPolicy.checkTelegramUser(ctx, bot, loginNonce.User, language); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	if err := saveBotUser(
+		ctx,
+		uc.transactor,
+		uc.botUserRepo,
+		bot.Id,
+		loginNonce.User,
+		input.ClientIP,
+		input.UserAgent,
+		language,
+	); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	redirectUri, err := acceptLoginRequest(ctx, uc.hydra, input.LoginChallenge, loginNonce.User.Id)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeSuccess, nil)
+
+	return &LoginByBotOutput{RedirectUri: redirectUri}, nil
+}
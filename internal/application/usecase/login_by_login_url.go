@@ -0,0 +1,319 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"time"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// initiateLoginUriMetadataKey is the OAuth2 client metadata key holding the OpenID Connect
+// third-party initiated login endpoint of the relying party.
+const initiateLoginUriMetadataKey = "initiate_login_uri"
+
+type LoginByLoginUrl struct {
+	issuer *url.URL
+
+	transactor        service.Transactor
+	hydra             *hydra.APIClient
+	widgetDataParser  service.TelegramWidgetDataParser
+	authHashVerifier  service.TelegramAuthHashVerifier
+	tokenVerifier     service.TelegramTokenVerifier
+	replayGuard       service.TelegramReplayGuard
+	botRepo           repository.BotRepositoryPort
+	botUserRepo       repository.BotUserRepositoryPort
+	accessPolicy      *accessPolicyChecker
+	loginEventRepo    repository.LoginEventRepositoryPort
+	loginHintStore    service.TelegramLoginNonceStore
+	authDataFreshness time.Duration
+	loginHintTTL      time.Duration
+}
+
+// NewLoginByLoginUrl creates the usecase; issuer is optional and is sent as the "iss" parameter when set.
+func NewLoginByLoginUrl(
+	issuer *url.URL,
+	transactor service.Transactor,
+	hydraClient *hydra.APIClient,
+	widgetDataParser service.TelegramWidgetDataParser,
+	authHashVerifier service.TelegramAuthHashVerifier,
+	tokenVerifier service.TelegramTokenVerifier,
+	replayGuard service.TelegramReplayGuard,
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+	accessPolicyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	loginHintStore service.TelegramLoginNonceStore,
+	authDataFreshness time.Duration,
+	loginHintTTL time.Duration,
+) (*LoginByLoginUrl, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if widgetDataParser == nil {
+		return nil, errors.New("widget data parser is nil")
+	}
+	if authHashVerifier == nil {
+		return nil, errors.New("auth hash verifier is nil")
+	}
+	if tokenVerifier == nil {
+		return nil, errors.New("token verifier is nil")
+	}
+	if replayGuard == nil {
+		return nil, errors.New("replay guard is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if accessPolicyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if loginHintStore == nil {
+		return nil, errors.New("login hint store is nil")
+	}
+	if authDataFreshness <= 0 {
+		return nil, errors.New("auth data freshness must be positive")
+	}
+	if loginHintTTL <= 0 {
+		return nil, errors.New("login hint TTL must be positive")
+	}
+
+	return &LoginByLoginUrl{
+		issuer:            issuer,
+		transactor:        transactor,
+		hydra:             hydraClient,
+		widgetDataParser:  widgetDataParser,
+		authHashVerifier:  authHashVerifier,
+		tokenVerifier:     tokenVerifier,
+		replayGuard:       replayGuard,
+		botRepo:           botRepo,
+		botUserRepo:       botUserRepo,
+		accessPolicy:      newAccessPolicyChecker(accessPolicyRepo, chatRepo, chatMemberChecker),
+		loginEventRepo:    loginEventRepo,
+		loginHintStore:    loginHintStore,
+		authDataFreshness: authDataFreshness,
+		loginHintTTL:      loginHintTTL,
+	}, nil
+}
+
+type (
+	LoginByLoginUrlInput struct {
+		BotId     int64
+		AuthData  map[string]any
+		UserAgent *string
+		Language  *string
+		ClientIP  netip.Addr
+	}
+	LoginByLoginUrlOutput struct {
+		RedirectUri string
+		// LoginHintBinding must be kept by the browser and passed back to ResolveLoginChallenge,
+		// which only accepts the login hint from the browser it was issued to.
+		LoginHintBinding string
+		LoginHintTTL     time.Duration
+	}
+)
+
+func (uc *LoginByLoginUrl) verifyAuthData(ctx context.Context, authData *service.TelegramAuthData, botToken string) error {
+	if authData == nil || authData.User == nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "user", nil))
+	}
+	if authData.IsExpired(uc.authDataFreshness) {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "auth_date", utils.Ptr("expired")))
+	}
+	if err := uc.authHashVerifier.Verify(authData.Raw, authData.Hash, botToken); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "hash", nil))
+	}
+	if err := uc.replayGuard.CheckAndMarkUsed(ctx, authData.Hash, uc.authDataFreshness); err != nil {
+		if errors.Is(err, service.ErrReplayDetected) {
+			return fmt.Errorf(
+				"%w: %w",
+				ErrInvalidInput,
+				NewObjectInvalidErr("telegram_auth_data", "hash", utils.Ptr("replay")),
+			)
+		}
+		return NewBadGatewayErr("telegram_replay_guard")
+	}
+
+	return nil
+}
+
+func (uc *LoginByLoginUrl) parseAndVerifyAuthData(ctx context.Context, authDataParams map[string]any, botToken string) (*service.TelegramAuthData, error) {
+	if len(authDataParams) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "payload", nil))
+	}
+
+	authData, err := uc.widgetDataParser.Parse(authDataParams)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "payload", nil))
+	}
+
+	if err := uc.verifyAuthData(ctx, authData, botToken); err != nil {
+		return nil, err
+	}
+
+	return authData, nil
+}
+
+func (uc *LoginByLoginUrl) getInitiateLoginUri(ctx context.Context, clientId string) (*url.URL, error) {
+	client, resp, err := uc.hydra.AdminApi.
+		GetOAuth2Client(ctx, clientId).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+
+	rawUri, ok := client.Metadata[initiateLoginUriMetadataKey].(string)
+	if !ok || rawUri == "" {
+		return nil, fmt.Errorf(
+			"%w: %w",
+			ErrInvalidInput,
+			NewObjectInvalidErr("client", initiateLoginUriMetadataKey, utils.Ptr("missing")))
+	}
+	initiateLoginUri, err := url.Parse(rawUri)
+	if err != nil || !initiateLoginUri.IsAbs() {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("client", initiateLoginUriMetadataKey, nil))
+	}
+
+	return initiateLoginUri, nil
+}
+
+// issueLoginHint stores the verified Telegram user under a one-time login hint. The hint is not bound
+// to a login challenge yet: ResolveLoginChallenge consumes it once the relying party starts the flow.
+// The returned binding ties the hint to the browser, so a leaked hint cannot be replayed elsewhere.
+func (uc *LoginByLoginUrl) issueLoginHint(ctx context.Context, botId int64, tgUser *service.TelegramUserData) (string, string, error) {
+	loginHint, binding, err := uc.loginHintStore.IssueLoginHint(ctx, botId, tgUser, uc.loginHintTTL)
+	if err != nil {
+		return "", "", NewBadGatewayErr("telegram_login_nonce_store")
+	}
+
+	return loginHint, binding, nil
+}
+
+func (uc *LoginByLoginUrl) buildRedirectUri(initiateLoginUri *url.URL, loginHint string) string {
+	redirectUri := *initiateLoginUri
+	redirectUriQuery := redirectUri.Query()
+	if uc.issuer != nil {
+		redirectUriQuery.Set("iss", uc.issuer.String())
+	}
+	redirectUriQuery.Set("login_hint", loginHint)
+	redirectUri.RawQuery = redirectUriQuery.Encode()
+
+	return redirectUri.String()
+}
+
+// rejectWithEvent records the failed login URL attempt and returns reason, which is shown on the error page.
+func (uc *LoginByLoginUrl) rejectWithEvent(ctx context.Context, attempt *loginAttempt, reason error) (*LoginByLoginUrlOutput, error) {
+	recordLoginRejection(ctx, uc.loginEventRepo, attempt, reason)
+	return nil, reason
+}
+
+func (uc *LoginByLoginUrl) Execute(ctx context.Context, input *LoginByLoginUrlInput) (*LoginByLoginUrlOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := verifyClientIP(input.ClientIP); err != nil {
+		return nil, err
+	}
+
+	attempt := &loginAttempt{
+		method:    entity.LoginMethodLoginUrl,
+		ip:        input.ClientIP,
+		userAgent: input.UserAgent,
+	}
+
+	bot, err := getLinkedBot(ctx, uc.botRepo, input.BotId)
+	if err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+	attempt.botId = utils.Ptr(bot.Id)
+	attempt.clientId = bot.ClientId
+
+	if err := checkBotAvailability(bot); err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	botToken, err := getVerifiedBotToken(ctx, uc.tokenVerifier, bot)
+	if err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	authData, err := uc.parseAndVerifyAuthData(ctx, input.AuthData, botToken)
+	if err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+	attempt.userId = utils.Ptr(authData.User.Id)
+
+	initiateLoginUri, err := uc.getInitiateLoginUri(ctx, *bot.ClientId)
+	if err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	if err := uc.accessPolicy.checkTelegramUser(ctx, bot, authData.User, input.Language); err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	if err := saveBotUser(
+		ctx,
+		uc.transactor,
+		uc.botUserRepo,
+		bot.Id,
+		authData.User,
+		input.ClientIP,
+		input.UserAgent,
+		input.Language,
+	); err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	loginHint, binding, err := uc.issueLoginHint(ctx, bot.Id, authData.User)
+	if err != nil {
+		return uc.rejectWithEvent(ctx, attempt, err)
+	}
+
+	// The login completes when the hint is used, which records its own event
+	recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeIssued, nil)
+
+	return &LoginByLoginUrlOutput{
+		RedirectUri:      uc.buildRedirectUri(initiateLoginUri, loginHint),
+		LoginHintBinding: binding,
+		LoginHintTTL:     uc.loginHintTTL,
+	}, nil
+}
@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type LoginByMiniApp struct {
+	transactor        service.Transactor
+	hydra             *hydra.APIClient
+	miniAppDataParser service.TelegramMiniAppDataParser
+	authHashVerifier  service.TelegramMiniAppAuthHashVerifier
+	signatureVerifier service.TelegramMiniAppSignatureVerifier
+	tokenVerifier     service.TelegramTokenVerifier
+	replayGuard       service.TelegramReplayGuard
+	botRepo           repository.BotRepositoryPort
+	botUserRepo       repository.BotUserRepositoryPort
+	accessPolicy      *accessPolicyChecker
+	loginEventRepo    repository.LoginEventRepositoryPort
+	authDataFreshness time.Duration
+	rateLimiter       *routeRateLimiter
+}
+
+func NewLoginByMiniApp(
+	transactor service.Transactor,
+	hydraClient *hydra.APIClient,
+	miniAppDataParser service.TelegramMiniAppDataParser,
+	authHashVerifier service.TelegramMiniAppAuthHashVerifier,
+	signatureVerifier service.TelegramMiniAppSignatureVerifier,
+	tokenVerifier service.TelegramTokenVerifier,
+	replayGuard service.TelegramReplayGuard,
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+	accessPolicyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	authDataFreshness time.Duration,
+	rateLimiter service.RateLimiter,
+	rateLimits RateLimits,
+) (*LoginByMiniApp, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if miniAppDataParser == nil {
+		return nil, errors.New("mini app data parser is nil")
+	}
+	if authHashVerifier == nil {
+		return nil, errors.New("auth hash verifier is nil")
+	}
+	if signatureVerifier == nil {
+		return nil, errors.New("signature verifier is nil")
+	}
+	if tokenVerifier == nil {
+		return nil, errors.New("token verifier is nil")
+	}
+	if replayGuard == nil {
+		return nil, errors.New("replay guard is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if accessPolicyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if authDataFreshness <= 0 {
+		return nil, errors.New("auth data freshness must be positive")
+	}
+	if rateLimiter == nil {
+		return nil, errors.New("rate limiter is nil")
+	}
+
+	return &LoginByMiniApp{
+		transactor:        transactor,
+		hydra:             hydraClient,
+		miniAppDataParser: miniAppDataParser,
+		authHashVerifier:  authHashVerifier,
+		signatureVerifier: signatureVerifier,
+		tokenVerifier:     tokenVerifier,
+		replayGuard:       replayGuard,
+		botRepo:           botRepo,
+		botUserRepo:       botUserRepo,
+		accessPolicy:      newAccessPolicyChecker(accessPolicyRepo, chatRepo, chatMemberChecker),
+		loginEventRepo:    loginEventRepo,
+		authDataFreshness: authDataFreshness,
+		rateLimiter:       newRouteRateLimiter(rateLimiter, "miniapp_callback", rateLimits),
+	}, nil
+}
+
+type (
+	LoginByMiniAppInput struct {
+		LoginChallenge string
+		AuthData       map[string]any
+		UserAgent      *string
+		Language       *string
+		ClientIP       netip.Addr
+	}
+	LoginByMiniAppOutput struct {
+		RedirectUri string
+	}
+)
+
+func (uc *LoginByMiniApp) verifySignature(ctx context.Context, authData *service.TelegramAuthData, bot *entity.Bot) error {
+	if bot.InitDataVerification == entity.BotInitDataVerificationSignature {
+		if err := uc.signatureVerifier.Verify(authData.Raw, authData.Signature, bot.Id); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "signature", nil))
+		}
+		return nil
+	}
+
+	botToken, err := getBotToken(ctx, bot)
+	if err != nil {
+		return err
+	}
+	if err := uc.authHashVerifier.Verify(authData.Raw, authData.Hash, botToken); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "hash", nil))
+	}
+	return nil
+}
+
+func (uc *LoginByMiniApp) verifyAuthData(ctx context.Context, authData *service.TelegramAuthData, bot *entity.Bot) error {
+	if authData == nil || authData.User == nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "user", nil))
+	}
+	if authData.IsExpired(uc.authDataFreshness) {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "auth_date", utils.Ptr("expired")))
+	}
+	if err := uc.verifySignature(ctx, authData, bot); err != nil {
+		return err
+	}
+	if err := uc.replayGuard.CheckAndMarkUsed(ctx, authData.Hash, uc.authDataFreshness); err != nil {
+		if errors.Is(err, service.ErrReplayDetected) {
+			return fmt.Errorf(
+				"%w: %w",
+				ErrInvalidInput,
+				NewObjectInvalidErr("telegram_auth_data", "hash", utils.Ptr("replay")),
+			)
+		}
+		return NewBadGatewayErr("telegram_replay_guard")
+	}
+
+	return nil
+}
+
+func (uc *LoginByMiniApp) parseAndVerifyAuthData(ctx context.Context, authDataParams map[string]any, bot *entity.Bot) (*service.TelegramAuthData, error) {
+	if len(authDataParams) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "payload", nil))
+	}
+
+	authData, err := uc.miniAppDataParser.Parse(authDataParams)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "payload", nil))
+	}
+
+	if err := uc.verifyAuthData(ctx, authData, bot); err != nil {
+		return nil, err
+	}
+
+	return authData, nil
+}
+
+func (uc *LoginByMiniApp) resolveLanguage(requested *string, tgUser *service.TelegramUserData) *string {
+	if requested != nil {
+		return requested
+	}
+	return tgUser.LanguageCode
+}
+
+func (uc *LoginByMiniApp) rejectAndBuildOutput(
+	ctx context.Context,
+	loginChallenge string,
+	attempt *loginAttempt,
+	reason error,
+) (*LoginByMiniAppOutput, error) {
+	recordLoginRejection(ctx, uc.loginEventRepo, attempt, reason)
+
+	redirectUri, rejectErr := rejectLoginRequest(ctx, uc.hydra, loginChallenge, reason)
+	if rejectErr != nil {
+		return nil, rejectErr
+	}
+
+	return &LoginByMiniAppOutput{RedirectUri: redirectUri}, nil
+}
+
+func (uc *LoginByMiniApp) Execute(ctx context.Context, input *LoginByMiniAppInput) (*LoginByMiniAppOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := verifyLoginChallenge(input.LoginChallenge); err != nil {
+		return nil, err
+	}
+
+	if err := verifyClientIP(input.ClientIP); err != nil {
+		return nil, err
+	}
+
+	attempt := &loginAttempt{
+		method:    entity.LoginMethodMiniApp,
+		ip:        input.ClientIP,
+		userAgent: input.UserAgent,
+	}
+
+	if err := uc.rateLimiter.checkIP(ctx, input.ClientIP); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	loginRequest, err := getLoginRequest(ctx, uc.hydra, input.LoginChallenge)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	clientId := *loginRequest.Client.ClientId
+	attempt.clientId = utils.Ptr(clientId)
+
+	bot, err := getClientBot(ctx, uc.botRepo, clientId)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+	attempt.botId = utils.Ptr(bot.Id)
+
+	if err := checkBotAvailability(bot); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	if err := uc.rateLimiter.checkBot(ctx, bot.Id); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	// Signature verification relies on Telegram's public key only, so the bot token is neither
+	// decrypted nor checked with Telegram for bots in signature mode.
+	if bot.InitDataVerification != entity.BotInitDataVerificationSignature {
+		if _, err := getVerifiedBotToken(ctx, uc.tokenVerifier, bot); err != nil {
+			return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+		}
+	}
+
+	authData, err := uc.parseAndVerifyAuthData(ctx, input.AuthData, bot)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+	attempt.userId = utils.Ptr(authData.User.Id)
+
+	if err := uc.rateLimiter.checkUser(ctx, authData.User.Id); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	if err := uc.accessPolicy.checkTelegramUser(ctx, bot, authData.User, uc.resolveLanguage(input.Language, authData.User)); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	if err := saveBotUser(
+		ctx,
+		uc.transactor,
+		uc.botUserRepo,
+		bot.Id,
+		authData.User,
+		input.ClientIP,
+		input.UserAgent,
+		uc.resolveLanguage(input.Language, authData.User),
+	); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	redirectUri, err := acceptLoginRequest(ctx, uc.hydra, input.LoginChallenge, authData.User.Id)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
+	}
+
+	recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeSuccess, nil)
+
+	return &LoginByMiniAppOutput{RedirectUri: redirectUri}, nil
+}
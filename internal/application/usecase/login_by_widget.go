@@ -4,13 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"net/netip"
-	"strconv"
 	"time"
 
 	hydra "github.com/ory/hydra-client-go"
-	"github.com/rs/zerolog"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
@@ -26,7 +23,10 @@ type LoginByWidget struct {
 	replayGuard       service.TelegramReplayGuard
 	botRepo           repository.BotRepositoryPort
 	botUserRepo       repository.BotUserRepositoryPort
+	accessPolicy      *accessPolicyChecker
+	loginEventRepo    repository.LoginEventRepositoryPort
 	authDataFreshness time.Duration
+	rateLimiter       *routeRateLimiter
 }
 
 func NewLoginByWidget(
@@ -38,7 +38,13 @@ func NewLoginByWidget(
 	replayGuard service.TelegramReplayGuard,
 	botRepo repository.BotRepositoryPort,
 	botUserRepo repository.BotUserRepositoryPort,
+	accessPolicyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+	loginEventRepo repository.LoginEventRepositoryPort,
 	authDataFreshness time.Duration,
+	rateLimiter service.RateLimiter,
+	rateLimits RateLimits,
 ) (*LoginByWidget, error) {
 	if transactor == nil {
 		return nil, errors.New("transactor is nil")
@@ -64,9 +70,24 @@ func NewLoginByWidget(
 	if botUserRepo == nil {
 		return nil, errors.New("bot user repository is nil")
 	}
+	if accessPolicyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
 	if authDataFreshness <= 0 {
 		return nil, errors.New("auth data freshness must be positive")
 	}
+	if rateLimiter == nil {
+		return nil, errors.New("rate limiter is nil")
+	}
 
 	return &LoginByWidget{
 		transactor:        transactor,
@@ -77,7 +98,10 @@ func NewLoginByWidget(
 		replayGuard:       replayGuard,
 		botRepo:           botRepo,
 		botUserRepo:       botUserRepo,
+		accessPolicy:      newAccessPolicyChecker(accessPolicyRepo, chatRepo, chatMemberChecker),
+		loginEventRepo:    loginEventRepo,
 		authDataFreshness: authDataFreshness,
+		rateLimiter:       newRouteRateLimiter(rateLimiter, "widget_callback", rateLimits),
 	}, nil
 }
 
@@ -94,76 +118,6 @@ type (
 	}
 )
 
-func (uc *LoginByWidget) verifyChallenge(loginChallenge string) error {
-	if loginChallenge == "" {
-		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
-	}
-
-	return nil
-}
-
-func (uc *LoginByWidget) verifyIP(clientIP netip.Addr) error {
-	if !clientIP.IsValid() || clientIP.IsUnspecified() {
-		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("request", "client_ip", nil))
-	}
-
-	return nil
-}
-
-func (uc *LoginByWidget) getLoginRequest(ctx context.Context, loginChallenge string) (*hydra.LoginRequest, error) {
-	loginRequest, resp, err := uc.hydra.AdminApi.
-		GetLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		Execute()
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, NewGatewayTimeoutErr("hydra")
-		}
-		if resp != nil {
-			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
-			}
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return nil, NewBadGatewayErr("hydra")
-			}
-			return nil, ErrUnexpected
-		}
-		return nil, NewBadGatewayErr("hydra")
-	}
-
-	return loginRequest, nil
-}
-
-func (uc *LoginByWidget) getBot(ctx context.Context, clientId string) (*entity.Bot, error) {
-	var bot entity.Bot
-	if err := uc.botRepo.GetByClientID(ctx, clientId, &bot); err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
-		}
-		return nil, ErrUnexpected
-	}
-	return &bot, nil
-}
-
-func (uc *LoginByWidget) verifyBotToken(ctx context.Context, botToken string) error {
-	if _, err := uc.tokenVerifier.Verify(ctx, botToken, nil); err != nil {
-		if errors.Is(err, service.ErrTelegramBotTokenMalformed) {
-			return fmt.Errorf(
-				"%w: %w",
-				ErrInvalidInput,
-				NewObjectInvalidErr("bot", "token", utils.Ptr("malformed")))
-		}
-		if errors.Is(err, service.ErrTelegramBotTokenInvalid) {
-			return fmt.Errorf(
-				"%w: %w",
-				ErrInvalidInput,
-				NewObjectInvalidErr("bot", "token", nil))
-		}
-		return ErrUnexpected
-	}
-	return nil
-}
-
 func (uc *LoginByWidget) verifyAuthData(ctx context.Context, authData *service.TelegramAuthData, botToken string) error {
 	if authData == nil || authData.User == nil {
 		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("telegram_auth_data", "user", nil))
@@ -205,236 +159,105 @@ func (uc *LoginByWidget) parseAndVerifyAuthData(ctx context.Context, authDataPar
 	return authData, nil
 }
 
-func (uc *LoginByWidget) ensureBotUserExists(
+func (uc *LoginByWidget) rejectAndBuildOutput(
 	ctx context.Context,
-	botId int64,
-	tgUser *service.TelegramUserData,
-	clientIP netip.Addr,
-	userAgent *string,
-	language *string,
-) error {
-	var botUser entity.BotUser
-	if err := uc.botUserRepo.GetByBotAndUser(ctx, botId, tgUser.Id, &botUser); err == nil {
-		return nil
-	} else if !errors.Is(err, repository.ErrNotFound) {
-		zerolog.Ctx(ctx).Error().
-			Err(err).
-			Int64("bot_id", botId).
-			Int64("user_id", tgUser.Id).
-			Msg("failed to load bot user by bot and user ids")
-		return ErrUnexpected
-	}
-
-	user, err := entity.NewUser(tgUser.FirstName, tgUser.LastName, tgUser.Username, tgUser.PhotoUrl, tgUser.IsPremium)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("user", "profile", nil))
-	}
-
-	newBotUser, err := entity.NewBotUser(botId, tgUser.Id, user, clientIP, userAgent, language)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_user", "data", nil))
-	}
+	loginChallenge string,
+	attempt *loginAttempt,
+	reason error,
+) (*LoginByWidgetOutput, error) {
+	recordLoginRejection(ctx, uc.loginEventRepo, attempt, reason)
 
-	if err := uc.botUserRepo.Create(ctx, newBotUser); err != nil {
-		if errors.Is(err, repository.ErrDuplicate) {
-			return nil
-		}
-		if errors.Is(err, repository.ErrNotFound) {
-			return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("bot", botId))
-		}
-		zerolog.Ctx(ctx).Error().
-			Err(err).
-			Int64("bot_id", botId).
-			Int64("user_id", tgUser.Id).
-			Msg("failed to create bot user")
-		return ErrUnexpected
-	}
-
-	return nil
-}
-
-func (uc *LoginByWidget) acceptLoginRequest(ctx context.Context, loginChallenge string, userId int64) (*hydra.CompletedRequest, error) {
-	acceptReq := hydra.NewAcceptLoginRequest(strconv.FormatInt(userId, 10))
-
-	completed, resp, err := uc.hydra.AdminApi.
-		AcceptLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		AcceptLoginRequest(*acceptReq).
-		Execute()
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, NewGatewayTimeoutErr("hydra")
-		}
-		if resp != nil {
-			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
-			}
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return nil, NewBadGatewayErr("hydra")
-			}
-			return nil, ErrUnexpected
-		}
-		return nil, NewBadGatewayErr("hydra")
+	redirectUri, rejectErr := rejectLoginRequest(ctx, uc.hydra, loginChallenge, reason)
+	if rejectErr != nil {
+		return nil, rejectErr
 	}
 
-	return completed, nil
+	return &LoginByWidgetOutput{RedirectUri: redirectUri}, nil
 }
 
-func (uc *LoginByWidget) mapRejectError(err error) (string, int64, string) {
-	if err == nil {
-		return "server_error", http.StatusInternalServerError, "unexpected authentication error"
-	}
-
-	var gatewayTimeoutErr *GatewayTimeoutErr
-	if errors.As(err, &gatewayTimeoutErr) {
-		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
-	}
-
-	var badGatewayErr *BadGatewayErr
-	if errors.As(err, &badGatewayErr) {
-		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
+func (uc *LoginByWidget) Execute(ctx context.Context, input *LoginByWidgetInput) (*LoginByWidgetOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
 	}
 
-	var objectInvalidErr *ObjectInvalidErr
-	if errors.As(err, &objectInvalidErr) {
-		if objectInvalidErr.Object == "telegram_auth_data" && objectInvalidErr.Field == "hash" &&
-			objectInvalidErr.Reason != nil && *objectInvalidErr.Reason == "replay" {
-			return "access_denied", http.StatusForbidden, "authentication data has already been used"
-		}
-		if objectInvalidErr.Object == "bot" && objectInvalidErr.Field == "token" {
-			return "unauthorized_client", http.StatusBadRequest, "client is linked to invalid bot credentials"
-		}
-		if objectInvalidErr.Object == "login" && objectInvalidErr.Field == "challenge" {
-			return "invalid_request", http.StatusBadRequest, "invalid login challenge"
-		}
-		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
+	if err := verifyLoginChallenge(input.LoginChallenge); err != nil {
+		return nil, err
 	}
 
-	var objectNotFoundErr *ObjectNotFoundErr
-	if errors.As(err, &objectNotFoundErr) {
-		if objectNotFoundErr.Object == "client" {
-			return "unauthorized_client", http.StatusBadRequest, "oauth2 client is not linked to bot configuration"
-		}
-		return "access_denied", http.StatusForbidden, "authentication cannot be completed"
+	if err := verifyClientIP(input.ClientIP); err != nil {
+		return nil, err
 	}
 
-	if errors.Is(err, ErrInvalidInput) {
-		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
+	attempt := &loginAttempt{
+		method:    entity.LoginMethodWidget,
+		ip:        input.ClientIP,
+		userAgent: input.UserAgent,
 	}
 
-	if errors.Is(err, ErrUnexpected) {
-		return "server_error", http.StatusInternalServerError, "internal authentication error"
+	if err := uc.rateLimiter.checkIP(ctx, input.ClientIP); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	return "server_error", http.StatusInternalServerError, "internal authentication error"
-}
-
-func (uc *LoginByWidget) rejectLoginRequest(ctx context.Context, loginChallenge string, reason error) (string, error) {
-	reasonDebug := "unknown"
-	if reason != nil {
-		reasonDebug = reason.Error()
-	}
-
-	oauth2Error, statusCode, description := uc.mapRejectError(reason)
-	rejectReq := hydra.NewRejectRequest()
-	rejectReq.SetError(oauth2Error)
-	rejectReq.SetStatusCode(int64(statusCode))
-	rejectReq.SetErrorDescription(description)
-	rejectReq.SetErrorHint("authentication request was rejected")
-	rejectReq.SetErrorDebug(reasonDebug)
-
-	completed, resp, err := uc.hydra.AdminApi.
-		RejectLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		RejectRequest(*rejectReq).
-		Execute()
+	loginRequest, err := getLoginRequest(ctx, uc.hydra, input.LoginChallenge)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return "", NewGatewayTimeoutErr("hydra")
-		}
-		if resp != nil {
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return "", NewBadGatewayErr("hydra")
-			}
-			return "", ErrUnexpected
-		}
-		return "", NewBadGatewayErr("hydra")
-	}
-
-	if completed == nil || completed.RedirectTo == "" {
-		return "", ErrUnexpected
-	}
-
-	return completed.RedirectTo, nil
-}
-
-func (uc *LoginByWidget) rejectAndBuildOutput(ctx context.Context, loginChallenge string, reason error) (*LoginByWidgetOutput, error) {
-	redirectUri, rejectErr := uc.rejectLoginRequest(ctx, loginChallenge, reason)
-	if rejectErr != nil {
-		return nil, rejectErr
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	return &LoginByWidgetOutput{RedirectUri: redirectUri}, nil
-}
+	clientId := *loginRequest.Client.ClientId
+	attempt.clientId = utils.Ptr(clientId)
 
-func (uc *LoginByWidget) Execute(ctx context.Context, input *LoginByWidgetInput) (*LoginByWidgetOutput, error) {
-	if input == nil {
-		return nil, errors.New("input is nil")
+	bot, err := getClientBot(ctx, uc.botRepo, clientId)
+	if err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
+	attempt.botId = utils.Ptr(bot.Id)
 
-	if err := uc.verifyChallenge(input.LoginChallenge); err != nil {
-		return nil, err
+	if err := checkBotAvailability(bot); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	if err := uc.verifyIP(input.ClientIP); err != nil {
-		return nil, err
+	if err := uc.rateLimiter.checkBot(ctx, bot.Id); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	loginRequest, err := uc.getLoginRequest(ctx, input.LoginChallenge)
+	botToken, err := getVerifiedBotToken(ctx, uc.tokenVerifier, bot)
 	if err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
-	if loginRequest == nil || loginRequest.Client.ClientId == nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, ErrUnexpected)
-	}
-
-	clientId := *loginRequest.Client.ClientId
 
-	bot, err := uc.getBot(ctx, clientId)
+	authData, err := uc.parseAndVerifyAuthData(ctx, input.AuthData, botToken)
 	if err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
+	attempt.userId = utils.Ptr(authData.User.Id)
 
-	if err := uc.verifyBotToken(ctx, bot.Token); err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+	if err := uc.rateLimiter.checkUser(ctx, authData.User.Id); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	authData, err := uc.parseAndVerifyAuthData(ctx, input.AuthData, bot.Token)
-	if err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+	if err := uc.accessPolicy.checkTelegramUser(ctx, bot, authData.User, input.Language); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	if err := uc.transactor.RunInTransaction(ctx, func(txCtx context.Context) error {
-		return uc.ensureBotUserExists(
-			txCtx,
-			bot.Id,
-			authData.User,
-			input.ClientIP,
-			input.UserAgent,
-			input.Language,
-		)
-	}); err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+	if err := saveBotUser(
+		ctx,
+		uc.transactor,
+		uc.botUserRepo,
+		bot.Id,
+		authData.User,
+		input.ClientIP,
+		input.UserAgent,
+		input.Language,
+	); err != nil {
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	completed, err := uc.acceptLoginRequest(ctx, input.LoginChallenge, authData.User.Id)
+	redirectUri, err := acceptLoginRequest(ctx, uc.hydra, input.LoginChallenge, authData.User.Id)
 	if err != nil {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, err)
+		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, attempt, err)
 	}
 
-	if completed == nil || completed.RedirectTo == "" {
-		return uc.rejectAndBuildOutput(ctx, input.LoginChallenge, ErrUnexpected)
-	}
+	recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeSuccess, nil)
 
-	return &LoginByWidgetOutput{RedirectUri: completed.RedirectTo}, nil
+	return &LoginByWidgetOutput{RedirectUri: redirectUri}, nil
 }
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// loginAttempt collects what is known about a login attempt to record it as a login event.
+type loginAttempt struct {
+	method    entity.LoginMethod
+	clientId  *string
+	botId     *int64
+	userId    *int64
+	ip        netip.Addr // Zero when unknown
+	userAgent *string
+}
+
+// recordLoginEvent stores the outcome of the login attempt. Failures are only logged,
+// so that the audit trail never breaks the login itself.
+func recordLoginEvent(
+	ctx context.Context,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	attempt *loginAttempt,
+	outcome entity.LoginOutcome,
+	errorCode *string,
+) {
+	var ip *netip.Addr
+	if attempt.ip.IsValid() && !attempt.ip.IsUnspecified() {
+		ip = utils.Ptr(attempt.ip)
+	}
+
+	event, err := entity.NewLoginEvent(
+		attempt.method,
+		outcome,
+		attempt.clientId,
+		attempt.botId,
+		attempt.userId,
+		errorCode,
+		ip,
+		attempt.userAgent,
+	)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("method", string(attempt.method)).
+			Str("outcome", string(outcome)).
+			Msg("failed to build login event")
+		return
+	}
+
+	if err := loginEventRepo.Create(context.WithoutCancel(ctx), event); err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("method", string(attempt.method)).
+			Str("outcome", string(outcome)).
+			Msg("failed to record login event")
+	}
+}
+
+// recordLoginRejection records a refused login attempt with the OAuth2 error of reason.
+// Unknown login challenges are not recorded: anyone can send them, so they would only flood the audit trail.
+func recordLoginRejection(
+	ctx context.Context,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	attempt *loginAttempt,
+	reason error,
+) {
+	if isInvalidLoginChallenge(reason) {
+		return
+	}
+
+	errorCode, _, _ := mapLoginRejectError(reason)
+	recordLoginEvent(ctx, loginEventRepo, attempt, entity.LoginOutcomeRejected, utils.Ptr(errorCode))
+}
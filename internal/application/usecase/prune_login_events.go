@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+// pruneLoginEventsBatchSize is the number of events removed per statement, so that a large backlog
+// does not lock the table for long.
+const pruneLoginEventsBatchSize = 1000
+
+// PruneLoginEvents removes login events older than the retention period, so that the audit log
+// does not keep IP addresses and user agents forever.
+type PruneLoginEvents struct {
+	loginEventRepo repository.LoginEventRepositoryPort
+	retention      time.Duration
+}
+
+func NewPruneLoginEvents(
+	loginEventRepo repository.LoginEventRepositoryPort,
+	retention time.Duration,
+) (*PruneLoginEvents, error) {
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if retention <= 0 {
+		return nil, errors.New("login event retention is not positive")
+	}
+
+	return &PruneLoginEvents{
+		loginEventRepo: loginEventRepo,
+		retention:      retention,
+	}, nil
+}
+
+type (
+	PruneLoginEventsInput  struct{}
+	PruneLoginEventsOutput struct {
+		Deleted int64 // Events removed
+	}
+)
+
+func (uc *PruneLoginEvents) Execute(ctx context.Context, input *PruneLoginEventsInput) (*PruneLoginEventsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	before := time.Now().UTC().Add(-uc.retention)
+
+	var output PruneLoginEventsOutput
+	for {
+		if err := ctx.Err(); err != nil {
+			return &output, err
+		}
+
+		deleted, err := uc.loginEventRepo.DeleteBefore(ctx, before, pruneLoginEventsBatchSize)
+		if err != nil {
+			return &output, fmt.Errorf("%w: failed to delete login events", ErrUnexpected)
+		}
+		output.Deleted += deleted
+		if deleted < pruneLoginEventsBatchSize {
+			return &output, nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// RateLimits configures the request limits of a route per client IP, bot and Telegram user.
+// Nil limits are not enforced.
+type RateLimits struct {
+	IP   *service.RateLimit
+	Bot  *service.RateLimit
+	User *service.RateLimit
+}
+
+// routeRateLimiter enforces the limits of a single route. Limiter failures are logged
+// and let the request through, so that a Redis outage does not block logins.
+type routeRateLimiter struct {
+	limiter service.RateLimiter
+	route   string
+	limits  RateLimits
+}
+
+func newRouteRateLimiter(limiter service.RateLimiter, route string, limits RateLimits) *routeRateLimiter {
+	return &routeRateLimiter{limiter: limiter, route: route, limits: limits}
+}
+
+func (l *routeRateLimiter) checkIP(ctx context.Context, ip netip.Addr) error {
+	if !ip.IsValid() {
+		return nil
+	}
+	return l.check(ctx, "ip", ip.String(), l.limits.IP)
+}
+
+func (l *routeRateLimiter) checkBot(ctx context.Context, botId int64) error {
+	return l.check(ctx, "bot", strconv.FormatInt(botId, 10), l.limits.Bot)
+}
+
+func (l *routeRateLimiter) checkUser(ctx context.Context, userId int64) error {
+	return l.check(ctx, "user", strconv.FormatInt(userId, 10), l.limits.User)
+}
+
+func (l *routeRateLimiter) check(ctx context.Context, scope, subject string, limit *service.RateLimit) error {
+	if limit == nil {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", l.route, scope, subject)
+	result, err := l.limiter.Allow(ctx, key, *limit)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("rate_limit_key", key).
+			Msg("failed to check rate limit, letting the request through")
+		return nil
+	}
+	if !result.Allowed {
+		zerolog.Ctx(ctx).Warn().
+			Str("rate_limit_key", key).
+			Dur("retry_after", result.RetryAfter).
+			Msg("rate limit exceeded")
+		return NewTooManyRequestsErr(scope, result.RetryAfter)
+	}
+
+	return nil
+}
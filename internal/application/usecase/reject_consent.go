@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	hydra "github.com/ory/hydra-client-go"
+)
+
+type RejectConsent struct {
+	hydra *hydra.APIClient
+}
+
+func NewRejectConsent(hydraClient *hydra.APIClient) (*RejectConsent, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &RejectConsent{
+		hydra: hydraClient,
+	}, nil
+}
+
+type (
+	RejectConsentInput struct {
+		ConsentChallenge string
+	}
+	RejectConsentOutput struct {
+		RedirectUri string
+	}
+)
+
+func (uc *RejectConsent) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("consent", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *RejectConsent) rejectConsentRequest(ctx context.Context, consentChallenge string) (*hydra.CompletedRequest, error) {
+	rejectReq := hydra.NewRejectRequest()
+	rejectReq.SetError("access_denied")
+	rejectReq.SetStatusCode(http.StatusForbidden)
+	rejectReq.SetErrorDescription("user denied the consent request")
+	rejectReq.SetErrorHint("consent request was rejected by the user")
+
+	completed, resp, err := uc.hydra.AdminApi.
+		RejectConsentRequest(ctx).
+		ConsentChallenge(consentChallenge).
+		RejectRequest(*rejectReq).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("consent", "challenge", nil))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+
+	return completed, nil
+}
+
+func (uc *RejectConsent) Execute(ctx context.Context, input *RejectConsentInput) (*RejectConsentOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyChallenge(input.ConsentChallenge); err != nil {
+		return nil, err
+	}
+
+	completed, err := uc.rejectConsentRequest(ctx, input.ConsentChallenge)
+	if err != nil {
+		return nil, err
+	}
+	if completed == nil || completed.RedirectTo == "" {
+		return nil, ErrUnexpected
+	}
+
+	return &RejectConsentOutput{RedirectUri: completed.RedirectTo}, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	hydra "github.com/ory/hydra-client-go"
+)
+
+// RejectLogout cancels a Hydra logout request. Hydra does not redirect after a rejected logout,
+// so the caller is responsible for telling the user that the session is still active.
+type RejectLogout struct {
+	hydra *hydra.APIClient
+}
+
+func NewRejectLogout(hydraClient *hydra.APIClient) (*RejectLogout, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &RejectLogout{
+		hydra: hydraClient,
+	}, nil
+}
+
+type (
+	RejectLogoutInput struct {
+		LogoutChallenge string
+	}
+	RejectLogoutOutput struct {
+	}
+)
+
+func (uc *RejectLogout) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *RejectLogout) rejectLogoutRequest(ctx context.Context, logoutChallenge string) error {
+	rejectReq := hydra.NewRejectRequest()
+	rejectReq.SetError("access_denied")
+	rejectReq.SetStatusCode(http.StatusForbidden)
+	rejectReq.SetErrorDescription("user cancelled the logout request")
+
+	resp, err := uc.hydra.AdminApi.
+		RejectLogoutRequest(ctx).
+		LogoutChallenge(logoutChallenge).
+		RejectRequest(*rejectReq).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+				return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return NewBadGatewayErr("hydra")
+			}
+			return ErrUnexpected
+		}
+		return NewBadGatewayErr("hydra")
+	}
+
+	return nil
+}
+
+func (uc *RejectLogout) Execute(ctx context.Context, input *RejectLogoutInput) (*RejectLogoutOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	if err := uc.verifyChallenge(input.LogoutChallenge); err != nil {
+		return nil, err
+	}
+
+	if err := uc.rejectLogoutRequest(ctx, input.LogoutChallenge); err != nil {
+		return nil, err
+	}
+
+	return &RejectLogoutOutput{}, nil
+}
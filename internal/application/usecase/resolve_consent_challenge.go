@@ -0,0 +1,267 @@
+//go:generate go-enum --values --names --nocase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+type ResolveConsentChallenge struct {
+	hydra       *hydra.APIClient
+	botRepo     repository.BotRepositoryPort
+	botUserRepo repository.BotUserRepositoryPort
+	mappingRepo repository.BotClaimMappingRepositoryPort
+	memberships *chatMembershipResolver
+}
+
+func NewResolveConsentChallenge(
+	hydraClient *hydra.APIClient,
+	botRepo repository.BotRepositoryPort,
+	botUserRepo repository.BotUserRepositoryPort,
+	mappingRepo repository.BotClaimMappingRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
+) (*ResolveConsentChallenge, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if botUserRepo == nil {
+		return nil, errors.New("bot user repository is nil")
+	}
+	if mappingRepo == nil {
+		return nil, errors.New("bot claim mapping repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
+
+	return &ResolveConsentChallenge{
+		hydra:       hydraClient,
+		botRepo:     botRepo,
+		botUserRepo: botUserRepo,
+		mappingRepo: mappingRepo,
+		memberships: newChatMembershipResolver(chatRepo, chatMemberChecker),
+	}, nil
+}
+
+type (
+	// ResolveConsentChallengeAction enum for consent challenge handling action
+	// ENUM(
+	//     Redirect
+	//     Render
+	// )
+	ResolveConsentChallengeAction string
+
+	ResolveConsentChallengeInput struct {
+		ConsentChallenge string
+	}
+	ResolveConsentChallengeOutput struct {
+		Action          ResolveConsentChallengeAction
+		RedirectUri     *string
+		ClientName      *string
+		BotUsername     *string
+		UserDisplayName *string
+		UserPhotoUrl    *string
+		RequestedScopes []string
+	}
+)
+
+func (uc *ResolveConsentChallenge) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("consent", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *ResolveConsentChallenge) getBot(ctx context.Context, clientId string) (*entity.Bot, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByClientID(ctx, clientId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
+		}
+		return nil, ErrUnexpected
+	}
+	return &bot, nil
+}
+
+func (uc *ResolveConsentChallenge) parseSubjectUserId(subject *string) (int64, error) {
+	if subject == nil || *subject == "" {
+		return 0, NewObjectInvalidErr("consent", "subject", utils.Ptr("empty"))
+	}
+	userId, err := strconv.ParseInt(*subject, 10, 64)
+	if err != nil {
+		return 0, NewObjectInvalidErr("consent", "subject", nil)
+	}
+	return userId, nil
+}
+
+func (uc *ResolveConsentChallenge) getBotUser(ctx context.Context, botId, userId int64) (*entity.BotUser, error) {
+	var botUser entity.BotUser
+	if err := uc.botUserRepo.GetByBotAndUser(ctx, botId, userId, &botUser); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, NewObjectNotFoundErr("user", userId)
+		}
+		return nil, ErrUnexpected
+	}
+	return &botUser, nil
+}
+
+func (uc *ResolveConsentChallenge) getClaimMappings(ctx context.Context, botId int64) ([]*entity.BotClaimMapping, error) {
+	mappings, err := uc.mappingRepo.GetByBot(ctx, botId)
+	if err != nil {
+		return nil, ErrUnexpected
+	}
+	return mappings, nil
+}
+
+func (uc *ResolveConsentChallenge) acceptConsentRequest(
+	ctx context.Context,
+	consentRequest *hydra.ConsentRequest,
+	botUser *entity.BotUser,
+	groups []string,
+	mappings []*entity.BotClaimMapping,
+) (*hydra.CompletedRequest, error) {
+	session := hydra.NewConsentRequestSession()
+	session.SetIdToken(buildIdTokenClaims(botUser, consentRequest.RequestedScope, groups, mappings))
+
+	acceptReq := hydra.NewAcceptConsentRequest()
+	acceptReq.SetGrantScope(consentRequest.RequestedScope)
+	acceptReq.SetGrantAccessTokenAudience(consentRequest.RequestedAccessTokenAudience)
+	acceptReq.SetSession(*session)
+
+	completed, resp, err := uc.hydra.AdminApi.
+		AcceptConsentRequest(ctx).
+		ConsentChallenge(consentRequest.Challenge).
+		AcceptConsentRequest(*acceptReq).
+		Execute()
+	if err != nil {
+		return nil, mapHydraChallengeError(err, resp, "consent")
+	}
+
+	return completed, nil
+}
+
+func (uc *ResolveConsentChallenge) rejectAfterChallenge(ctx context.Context, consentChallenge string, reason error) (*ResolveConsentChallengeOutput, error) {
+	zerolog.Ctx(ctx).Warn().
+		Err(reason).
+		Str("consent_challenge", consentChallenge).
+		Msg("resolve consent challenge failed, rejecting consent request in hydra")
+
+	redirectUri, err := rejectConsentRequest(ctx, uc.hydra, consentChallenge, reason)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("consent_challenge", consentChallenge).
+			Msg("failed to reject consent request in hydra")
+		return nil, err
+	}
+
+	return uc.buildRedirectOutput(redirectUri), nil
+}
+
+func (uc *ResolveConsentChallenge) buildRedirectOutput(redirectUri string) *ResolveConsentChallengeOutput {
+	return &ResolveConsentChallengeOutput{
+		Action:      ResolveConsentChallengeActionRedirect,
+		RedirectUri: utils.Ptr(redirectUri),
+	}
+}
+
+func (uc *ResolveConsentChallenge) buildRenderOutput(
+	consentRequest *hydra.ConsentRequest,
+	bot *entity.Bot,
+	botUser *entity.BotUser,
+) *ResolveConsentChallengeOutput {
+	clientName := consentRequest.GetClient().ClientName
+	if clientName == nil || *clientName == "" {
+		clientName = utils.Ptr(bot.Name)
+	}
+
+	userDisplayName := botUser.User.FirstName
+	if botUser.User.LastName != nil {
+		userDisplayName += " " + *botUser.User.LastName
+	}
+
+	var userPhotoUrl *string
+	if botUser.User.PhotoUrl != nil {
+		userPhotoUrl = utils.Ptr(botUser.User.PhotoUrl.String())
+	}
+
+	return &ResolveConsentChallengeOutput{
+		Action:          ResolveConsentChallengeActionRender,
+		ClientName:      clientName,
+		BotUsername:     utils.Ptr(bot.Username),
+		UserDisplayName: utils.Ptr(userDisplayName),
+		UserPhotoUrl:    userPhotoUrl,
+		RequestedScopes: consentRequest.RequestedScope,
+	}
+}
+
+func (uc *ResolveConsentChallenge) Execute(ctx context.Context, input *ResolveConsentChallengeInput) (*ResolveConsentChallengeOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	challenge := input.ConsentChallenge
+	if err := uc.verifyChallenge(challenge); err != nil {
+		return nil, err
+	}
+
+	consentRequest, err := getConsentRequest(ctx, uc.hydra, challenge)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+	clientId := *consentRequest.Client.ClientId
+
+	bot, err := uc.getBot(ctx, clientId)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	userId, err := uc.parseSubjectUserId(consentRequest.Subject)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	botUser, err := uc.getBotUser(ctx, bot.Id, userId)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, err)
+	}
+
+	if consentRequest.GetSkip() {
+		mappings, err := uc.getClaimMappings(ctx, bot.Id)
+		if err != nil {
+			return uc.rejectAfterChallenge(ctx, challenge, err)
+		}
+
+		groups, err := uc.memberships.resolveGroupsClaim(ctx, bot, userId, consentRequest.RequestedScope)
+		if err != nil {
+			return uc.rejectAfterChallenge(ctx, challenge, err)
+		}
+
+		completed, err := uc.acceptConsentRequest(ctx, consentRequest, botUser, groups, mappings)
+		if err != nil {
+			return uc.rejectAfterChallenge(ctx, challenge, err)
+		}
+		if completed == nil || completed.RedirectTo == "" {
+			return uc.rejectAfterChallenge(ctx, challenge, ErrUnexpected)
+		}
+		return uc.buildRedirectOutput(completed.RedirectTo), nil
+	}
+
+	return uc.buildRenderOutput(consentRequest, bot, botUser), nil
+}
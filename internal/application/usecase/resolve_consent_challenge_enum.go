@@ -0,0 +1,71 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package usecase
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ResolveConsentChallengeActionRedirect is a ResolveConsentChallengeAction of type Redirect.
+	ResolveConsentChallengeActionRedirect ResolveConsentChallengeAction = "Redirect"
+	// ResolveConsentChallengeActionRender is a ResolveConsentChallengeAction of type Render.
+	ResolveConsentChallengeActionRender ResolveConsentChallengeAction = "Render"
+)
+
+var ErrInvalidResolveConsentChallengeAction = fmt.Errorf("not a valid ResolveConsentChallengeAction, try [%s]", strings.Join(_ResolveConsentChallengeActionNames, ", "))
+
+var _ResolveConsentChallengeActionNames = []string{
+	string(ResolveConsentChallengeActionRedirect),
+	string(ResolveConsentChallengeActionRender),
+}
+
+// ResolveConsentChallengeActionNames returns a list of possible string values of ResolveConsentChallengeAction.
+func ResolveConsentChallengeActionNames() []string {
+	tmp := make([]string, len(_ResolveConsentChallengeActionNames))
+	copy(tmp, _ResolveConsentChallengeActionNames)
+	return tmp
+}
+
+// ResolveConsentChallengeActionValues returns a list of the values for ResolveConsentChallengeAction
+func ResolveConsentChallengeActionValues() []ResolveConsentChallengeAction {
+	return []ResolveConsentChallengeAction{
+		ResolveConsentChallengeActionRedirect,
+		ResolveConsentChallengeActionRender,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ResolveConsentChallengeAction) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ResolveConsentChallengeAction) IsValid() bool {
+	_, err := ParseResolveConsentChallengeAction(string(x))
+	return err == nil
+}
+
+var _ResolveConsentChallengeActionValue = map[string]ResolveConsentChallengeAction{
+	"Redirect": ResolveConsentChallengeActionRedirect,
+	"redirect": ResolveConsentChallengeActionRedirect,
+	"Render":   ResolveConsentChallengeActionRender,
+	"render":   ResolveConsentChallengeActionRender,
+}
+
+// ParseResolveConsentChallengeAction attempts to convert a string to a ResolveConsentChallengeAction.
+func ParseResolveConsentChallengeAction(name string) (ResolveConsentChallengeAction, error) {
+	if x, ok := _ResolveConsentChallengeActionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ResolveConsentChallengeActionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ResolveConsentChallengeAction(""), fmt.Errorf("%s is %w", name, ErrInvalidResolveConsentChallengeAction)
+}
@@ -5,9 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"net/netip"
 	"net/url"
 	"strconv"
+	"time"
 
 	hydra "github.com/ory/hydra-client-go"
 	"github.com/rs/zerolog"
@@ -18,22 +19,36 @@ import (
 )
 
 type ResolveLoginChallenge struct {
-	baseUri         *url.URL
-	telegramAuthUri *url.URL
-
-	hydra         *hydra.APIClient
-	botRepo       repository.BotRepositoryPort
-	botUserRepo   repository.BotUserRepositoryPort
-	tokenVerifier service.TelegramTokenVerifier
+	baseUri             *url.URL
+	telegramAuthUri     *url.URL
+	telegramDeepLinkUri *url.URL
+
+	hydra          *hydra.APIClient
+	botRepo        repository.BotRepositoryPort
+	botUserRepo    repository.BotUserRepositoryPort
+	accessPolicy   *accessPolicyChecker
+	tokenVerifier  service.TelegramTokenVerifier
+	nonceStore     service.TelegramLoginNonceStore
+	loginEventRepo repository.LoginEventRepositoryPort
+	nonceTTL       time.Duration
+	botLogin       bool // Offer the bot deep link login
 }
 
 func NewResolveLoginChallenge(
 	baseUri *url.URL,
 	telegramAuthUri *url.URL,
+	telegramDeepLinkUri *url.URL,
 	hydraClient *hydra.APIClient,
 	botRepo repository.BotRepositoryPort,
 	botUserRepo repository.BotUserRepositoryPort,
+	accessPolicyRepo repository.BotAccessPolicyRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+	chatMemberChecker service.TelegramChatMemberChecker,
 	tokenVerifier service.TelegramTokenVerifier,
+	nonceStore service.TelegramLoginNonceStore,
+	loginEventRepo repository.LoginEventRepositoryPort,
+	nonceTTL time.Duration,
+	botLogin bool,
 ) (*ResolveLoginChallenge, error) {
 	if baseUri == nil {
 		return nil, errors.New("base URI is nil")
@@ -41,6 +56,9 @@ func NewResolveLoginChallenge(
 	if telegramAuthUri == nil {
 		return nil, errors.New("telegram auth URI is nil")
 	}
+	if telegramDeepLinkUri == nil {
+		return nil, errors.New("telegram deep link URI is nil")
+	}
 	if hydraClient == nil {
 		return nil, errors.New("hydra client is nil")
 	}
@@ -50,17 +68,41 @@ func NewResolveLoginChallenge(
 	if botUserRepo == nil {
 		return nil, errors.New("bot user repository is nil")
 	}
+	if accessPolicyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+	if chatMemberChecker == nil {
+		return nil, errors.New("chat member checker is nil")
+	}
 	if tokenVerifier == nil {
 		return nil, errors.New("token verifier is nil")
 	}
+	if nonceStore == nil {
+		return nil, errors.New("login nonce store is nil")
+	}
+	if loginEventRepo == nil {
+		return nil, errors.New("login event repository is nil")
+	}
+	if nonceTTL <= 0 {
+		return nil, errors.New("login nonce TTL must be positive")
+	}
 
 	return &ResolveLoginChallenge{
-		baseUri:         baseUri,
-		telegramAuthUri: telegramAuthUri,
-		hydra:           hydraClient,
-		botRepo:         botRepo,
-		botUserRepo:     botUserRepo,
-		tokenVerifier:   tokenVerifier,
+		baseUri:             baseUri,
+		telegramAuthUri:     telegramAuthUri,
+		telegramDeepLinkUri: telegramDeepLinkUri,
+		hydra:               hydraClient,
+		botRepo:             botRepo,
+		botUserRepo:         botUserRepo,
+		accessPolicy:        newAccessPolicyChecker(accessPolicyRepo, chatRepo, chatMemberChecker),
+		tokenVerifier:       tokenVerifier,
+		nonceStore:          nonceStore,
+		loginEventRepo:      loginEventRepo,
+		nonceTTL:            nonceTTL,
+		botLogin:            botLogin,
 	}, nil
 }
 
@@ -74,82 +116,59 @@ type (
 
 	ResolveLoginChallengeInput struct {
 		LoginChallenge string
+		UserAgent      *string
+		ClientIP       netip.Addr // Recorded in login events when valid
+		// LoginHintBinding is kept by the browser that received a login hint from LoginByLoginUrl.
+		// Login hints are only accepted together with it.
+		LoginHintBinding *string
 	}
 	ResolveLoginChallengeOutput struct {
 		Action             ResolveLoginChallengeAction
 		RedirectUri        *string
 		WidgetUri          *string
 		MiniAppCallbackUri *string
-	}
-)
+		BotLoginUri        *string // Telegram deep link that starts the bot login
+		BotCallbackUri     *string // Polled by the login page until the bot login is confirmed
 
-func (uc *ResolveLoginChallenge) verifyChallenge(challenge string) error {
-	if challenge == "" {
-		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
+		// Branding of the login page
+		BotName             *string
+		BotShortDescription *string // Nil when the bot has no short description
+		BotPhotoUri         *string // Nil when the bot has no profile photo
 	}
-	return nil
-}
+)
 
-func (uc *ResolveLoginChallenge) getBot(ctx context.Context, clientId string) (*entity.Bot, error) {
-	var bot entity.Bot
-	if err := uc.botRepo.GetByClientID(ctx, clientId, &bot); err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectNotFoundErr("client", clientId))
-		}
-		return nil, ErrUnexpected
+func (uc *ResolveLoginChallenge) buildBotLoginUris(ctx context.Context, loginChallenge string, bot *entity.Bot) (*string, *string) {
+	nonce, err := uc.nonceStore.Issue(ctx, bot.Id, loginChallenge, uc.nonceTTL)
+	if err != nil {
+		// Bot login is optional, the widget and the Mini App still work without it.
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Str("login_challenge", loginChallenge).
+			Int64("bot_id", bot.Id).
+			Msg("failed to issue bot login nonce, bot login is unavailable")
+		return nil, nil
 	}
-	return &bot, nil
-}
 
-func (uc *ResolveLoginChallenge) verifyBotToken(ctx context.Context, botToken string) error {
-	if _, err := uc.tokenVerifier.Verify(ctx, botToken, nil); err != nil {
-		if errors.Is(err, service.ErrTelegramBotTokenMalformed) {
-			return fmt.Errorf(
-				"%w: %w",
-				ErrInvalidInput,
-				NewObjectInvalidErr("bot", "token", utils.Ptr("malformed")))
-		}
-		if errors.Is(err, service.ErrTelegramBotTokenInvalid) {
-			return fmt.Errorf(
-				"%w: %w",
-				ErrInvalidInput,
-				NewObjectInvalidErr("bot", "token", nil))
-		}
-		return ErrUnexpected
-	}
-	return nil
-}
+	botLoginUri := *uc.telegramDeepLinkUri.JoinPath(bot.Username)
+	botLoginUriQuery := botLoginUri.Query()
+	botLoginUriQuery.Set("start", nonce)
+	botLoginUri.RawQuery = botLoginUriQuery.Encode()
 
-func (uc *ResolveLoginChallenge) getLoginRequest(ctx context.Context, loginChallenge string) (*hydra.LoginRequest, error) {
-	loginRequest, resp, err := uc.hydra.AdminApi.
-		GetLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		Execute()
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, NewGatewayTimeoutErr("hydra")
-		}
-		if resp != nil {
-			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
-			}
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return nil, NewBadGatewayErr("hydra")
-			}
-			return nil, ErrUnexpected
-		}
-		return nil, NewBadGatewayErr("hydra")
-	}
+	botCallbackUri := *uc.baseUri.JoinPath("/bot/callback")
+	botCallbackUriQuery := botCallbackUri.Query()
+	botCallbackUriQuery.Set("login_challenge", loginChallenge)
+	botCallbackUriQuery.Set("nonce", nonce)
+	botCallbackUri.RawQuery = botCallbackUriQuery.Encode()
 
-	return loginRequest, nil
+	return utils.Ptr(botLoginUri.String()), utils.Ptr(botCallbackUri.String())
 }
 
-func (uc *ResolveLoginChallenge) buildRenderOutput(loginChallenge string, bot *entity.Bot) *ResolveLoginChallengeOutput {
+func (uc *ResolveLoginChallenge) buildRenderOutput(ctx context.Context, loginChallenge string, bot *entity.Bot) *ResolveLoginChallengeOutput {
 	origin := *uc.baseUri
 	origin = *origin.JoinPath("/login")
 
-	widgetCallbackUri := *uc.baseUri
-	widgetCallbackUri = *origin.JoinPath("/widget/callback")
+	// Callbacks are served at the root of the base URI, not below the login page
+	widgetCallbackUri := *uc.baseUri.JoinPath("/widget/callback")
 	widgetCallbackUriQuery := widgetCallbackUri.Query()
 	widgetCallbackUriQuery.Set("login_challenge", loginChallenge)
 	widgetCallbackUri.RawQuery = widgetCallbackUriQuery.Encode()
@@ -162,17 +181,29 @@ func (uc *ResolveLoginChallenge) buildRenderOutput(loginChallenge string, bot *e
 	widgetUriQuery.Set("return_to", widgetCallbackUri.String())
 	widgetUri.RawQuery = widgetUriQuery.Encode()
 
-	miniappCallbackUri := *uc.baseUri
-	miniappCallbackUri = *origin.JoinPath("/miniapp/callback")
+	miniappCallbackUri := *uc.baseUri.JoinPath("/miniapp/callback")
 	miniappCallbackUriQuery := miniappCallbackUri.Query()
 	miniappCallbackUriQuery.Set("login_challenge", loginChallenge)
 	miniappCallbackUri.RawQuery = miniappCallbackUriQuery.Encode()
 
-	return &ResolveLoginChallengeOutput{
+	var botLoginUri, botCallbackUri *string
+	if uc.botLogin {
+		botLoginUri, botCallbackUri = uc.buildBotLoginUris(ctx, loginChallenge, bot)
+	}
+
+	output := &ResolveLoginChallengeOutput{
 		Action:             ResolveLoginChallengeActionRender,
 		WidgetUri:          utils.Ptr(widgetUri.String()),
 		MiniAppCallbackUri: utils.Ptr(miniappCallbackUri.String()),
+		BotLoginUri:        botLoginUri,
+		BotCallbackUri:     botCallbackUri,
+		BotName:            utils.Ptr(bot.Name),
+		BotPhotoUri:        buildBotPhotoUri(uc.baseUri, bot),
 	}
+	if bot.ShortDescription != "" {
+		output.BotShortDescription = utils.Ptr(bot.ShortDescription)
+	}
+	return output
 }
 
 func (uc *ResolveLoginChallenge) buildRedirectOutput(redirectUri string) *ResolveLoginChallengeOutput {
@@ -182,16 +213,18 @@ func (uc *ResolveLoginChallenge) buildRedirectOutput(redirectUri string) *Resolv
 	}
 }
 
-func (uc *ResolveLoginChallenge) ensureBotUserExists(ctx context.Context, botId, userId int64) error {
+// checkBotUser ensures the user has signed in to the bot before and still satisfies its access policy,
+// evaluated against the stored profile.
+func (uc *ResolveLoginChallenge) checkBotUser(ctx context.Context, bot *entity.Bot, userId int64) error {
 	var user entity.BotUser
-	if err := uc.botUserRepo.GetByBotAndUser(ctx, botId, userId, &user); err != nil {
+	if err := uc.botUserRepo.GetByBotAndUser(ctx, bot.Id, userId, &user); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return NewObjectNotFoundErr("user", userId)
 		}
 		return ErrUnexpected
 	}
 
-	return nil
+	return uc.accessPolicy.check(ctx, bot, userId, &user.User, user.Language)
 }
 
 func (uc *ResolveLoginChallenge) parseSubjectUserId(subject string) (int64, error) {
@@ -205,124 +238,45 @@ func (uc *ResolveLoginChallenge) parseSubjectUserId(subject string) (int64, erro
 	return userId, nil
 }
 
-func (uc *ResolveLoginChallenge) acceptLoginRequest(ctx context.Context, loginChallenge string, userId int64) (*hydra.CompletedRequest, error) {
-	acceptReq := hydra.NewAcceptLoginRequest(strconv.FormatInt(userId, 10))
-
-	completed, resp, err := uc.hydra.AdminApi.
-		AcceptLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		AcceptLoginRequest(*acceptReq).
-		Execute()
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, NewGatewayTimeoutErr("hydra")
-		}
-		if resp != nil {
-			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
-				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("login", "challenge", nil))
-			}
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return nil, NewBadGatewayErr("hydra")
-			}
-			return nil, ErrUnexpected
-		}
-		return nil, NewBadGatewayErr("hydra")
-	}
-
-	return completed, nil
-}
-
-func (uc *ResolveLoginChallenge) mapRejectError(err error) (string, int64, string) {
-	if err == nil {
-		return "server_error", http.StatusInternalServerError, "unexpected authentication error"
+// consumeLoginHint resolves a login hint issued by LoginByLoginUrl into the Telegram user id.
+// Hints are single-use, only valid for the bot they were issued for and only accepted from the browser
+// holding their binding. Without the binding the hint is left untouched and the login page is rendered.
+func (uc *ResolveLoginChallenge) consumeLoginHint(ctx context.Context, loginHint string, binding *string, bot *entity.Bot) (int64, error) {
+	if binding == nil || *binding == "" {
+		return 0, NewObjectInvalidErr("login", "login_hint", utils.Ptr("unbound"))
 	}
 
-	var gatewayTimeoutErr *GatewayTimeoutErr
-	if errors.As(err, &gatewayTimeoutErr) {
-		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
-	}
-
-	var badGatewayErr *BadGatewayErr
-	if errors.As(err, &badGatewayErr) {
-		return "temporarily_unavailable", http.StatusServiceUnavailable, "authentication service is temporarily unavailable"
-	}
-
-	var objectInvalidErr *ObjectInvalidErr
-	if errors.As(err, &objectInvalidErr) {
-		if objectInvalidErr.Object == "bot" && objectInvalidErr.Field == "token" {
-			return "unauthorized_client", http.StatusBadRequest, "client is linked to invalid bot credentials"
-		}
-		if objectInvalidErr.Object == "login" && objectInvalidErr.Field == "challenge" {
-			return "invalid_request", http.StatusBadRequest, "invalid login challenge"
-		}
-		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
-	}
-
-	var objectNotFoundErr *ObjectNotFoundErr
-	if errors.As(err, &objectNotFoundErr) {
-		if objectNotFoundErr.Object == "client" {
-			return "unauthorized_client", http.StatusBadRequest, "oauth2 client is not linked to bot configuration"
-		}
-		return "access_denied", http.StatusForbidden, "authentication cannot be completed"
-	}
-
-	if errors.Is(err, ErrInvalidInput) {
-		return "invalid_request", http.StatusBadRequest, "invalid authentication request"
-	}
-
-	if errors.Is(err, ErrUnexpected) {
-		return "server_error", http.StatusInternalServerError, "internal authentication error"
-	}
-
-	return "server_error", http.StatusInternalServerError, "internal authentication error"
-}
-
-func (uc *ResolveLoginChallenge) rejectLoginRequest(ctx context.Context, loginChallenge string, reason error) (*ResolveLoginChallengeOutput, error) {
-	reasonDebug := "unknown"
-	if reason != nil {
-		reasonDebug = reason.Error()
-	}
-
-	oauth2Error, statusCode, description := uc.mapRejectError(reason)
-	rejectReq := hydra.NewRejectRequest()
-	rejectReq.SetError(oauth2Error)
-	rejectReq.SetStatusCode(int64(statusCode))
-	rejectReq.SetErrorDescription(description)
-	rejectReq.SetErrorHint("authentication request was rejected")
-	rejectReq.SetErrorDebug(reasonDebug)
-
-	completed, resp, err := uc.hydra.AdminApi.
-		RejectLoginRequest(ctx).
-		LoginChallenge(loginChallenge).
-		RejectRequest(*rejectReq).
-		Execute()
+	loginNonce, err := uc.nonceStore.ConsumeLoginHint(ctx, loginHint, *binding)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, NewGatewayTimeoutErr("hydra")
+		if errors.Is(err, service.ErrLoginNonceNotFound) {
+			return 0, NewObjectInvalidErr("login", "login_hint", utils.Ptr("expired"))
 		}
-		if resp != nil {
-			if resp.StatusCode >= http.StatusInternalServerError {
-				return nil, NewBadGatewayErr("hydra")
-			}
-			return nil, ErrUnexpected
+		if errors.Is(err, service.ErrLoginHintBindingMismatch) {
+			return 0, NewObjectInvalidErr("login", "login_hint", utils.Ptr("unbound"))
 		}
-		return nil, NewBadGatewayErr("hydra")
+		return 0, NewBadGatewayErr("telegram_login_nonce_store")
 	}
-
-	if completed == nil || completed.RedirectTo == "" {
-		return nil, ErrUnexpected
+	if loginNonce.LoginChallenge != "" || loginNonce.BotId != bot.Id || !loginNonce.IsConfirmed() {
+		return 0, NewObjectInvalidErr("login", "login_hint", nil)
 	}
 
-	return uc.buildRedirectOutput(completed.RedirectTo), nil
+	return loginNonce.User.Id, nil
 }
 
-func (uc *ResolveLoginChallenge) rejectAfterChallenge(ctx context.Context, loginChallenge string, reason error) (*ResolveLoginChallengeOutput, error) {
+func (uc *ResolveLoginChallenge) rejectAfterChallenge(
+	ctx context.Context,
+	loginChallenge string,
+	attempt *loginAttempt,
+	reason error,
+) (*ResolveLoginChallengeOutput, error) {
 	zerolog.Ctx(ctx).Warn().
 		Err(reason).
 		Str("login_challenge", loginChallenge).
 		Msg("resolve login challenge failed, rejecting login request in hydra")
 
-	output, err := uc.rejectLoginRequest(ctx, loginChallenge, reason)
+	recordLoginRejection(ctx, uc.loginEventRepo, attempt, reason)
+
+	redirectUri, err := rejectLoginRequest(ctx, uc.hydra, loginChallenge, reason)
 	if err != nil {
 		zerolog.Ctx(ctx).Error().
 			Err(err).
@@ -331,7 +285,7 @@ func (uc *ResolveLoginChallenge) rejectAfterChallenge(ctx context.Context, login
 		return nil, err
 	}
 
-	return output, nil
+	return uc.buildRedirectOutput(redirectUri), nil
 }
 
 func (uc *ResolveLoginChallenge) Execute(ctx context.Context, input *ResolveLoginChallengeInput) (*ResolveLoginChallengeOutput, error) {
@@ -340,44 +294,58 @@ func (uc *ResolveLoginChallenge) Execute(ctx context.Context, input *ResolveLogi
 	}
 
 	challenge := input.LoginChallenge
-	if err := uc.verifyChallenge(challenge); err != nil {
+	if err := verifyLoginChallenge(challenge); err != nil {
 		return nil, err
 	}
 
-	loginRequest, err := uc.getLoginRequest(ctx, challenge)
-	if err != nil {
-		return uc.rejectAfterChallenge(ctx, challenge, err)
+	attempt := &loginAttempt{
+		method:    entity.LoginMethodChallenge,
+		ip:        input.ClientIP,
+		userAgent: input.UserAgent,
 	}
-	if loginRequest == nil || loginRequest.Client.ClientId == nil {
-		return uc.rejectAfterChallenge(ctx, challenge, ErrUnexpected)
+
+	loginRequest, err := getLoginRequest(ctx, uc.hydra, challenge)
+	if err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, attempt, err)
 	}
 	clientId := *loginRequest.Client.ClientId
+	attempt.clientId = utils.Ptr(clientId)
+
+	loginHint := loginRequest.GetOidcContext().LoginHint
+	if loginRequest.Skip {
+		attempt.method = entity.LoginMethodSkip
+	} else if loginHint != nil && *loginHint != "" {
+		attempt.method = entity.LoginMethodLoginHint
+	}
 
-	bot, err := uc.getBot(ctx, clientId)
+	bot, err := getClientBot(ctx, uc.botRepo, clientId)
 	if err != nil {
-		return uc.rejectAfterChallenge(ctx, challenge, err)
+		return uc.rejectAfterChallenge(ctx, challenge, attempt, err)
+	}
+	attempt.botId = utils.Ptr(bot.Id)
+
+	if err := checkBotAvailability(bot); err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, attempt, err)
 	}
 
-	if err := uc.verifyBotToken(ctx, bot.Token); err != nil {
-		return uc.rejectAfterChallenge(ctx, challenge, err)
+	if _, err := getVerifiedBotToken(ctx, uc.tokenVerifier, bot); err != nil {
+		return uc.rejectAfterChallenge(ctx, challenge, attempt, err)
 	}
 
 	if loginRequest.Skip {
 		skipUserId, err := uc.parseSubjectUserId(loginRequest.Subject)
 		if err == nil {
-			err = uc.ensureBotUserExists(ctx, bot.Id, skipUserId)
+			attempt.userId = utils.Ptr(skipUserId)
+			err = uc.checkBotUser(ctx, bot, skipUserId)
 		}
 
 		if err == nil {
-			completed, acceptErr := uc.acceptLoginRequest(ctx, loginRequest.Challenge, skipUserId)
-			if acceptErr == nil && completed != nil && completed.RedirectTo != "" {
-				return uc.buildRedirectOutput(completed.RedirectTo), nil
-			}
-			if acceptErr != nil {
-				err = acceptErr
-			} else {
-				err = ErrUnexpected
+			redirectUri, acceptErr := acceptLoginRequest(ctx, uc.hydra, loginRequest.Challenge, skipUserId)
+			if acceptErr == nil {
+				recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeSuccess, nil)
+				return uc.buildRedirectOutput(redirectUri), nil
 			}
+			err = acceptErr
 		}
 
 		zerolog.Ctx(ctx).Warn().
@@ -386,7 +354,36 @@ func (uc *ResolveLoginChallenge) Execute(ctx context.Context, input *ResolveLogi
 			Str("client_id", clientId).
 			Str("subject", loginRequest.Subject).
 			Msg("skip login failed, falling back to interactive login UI")
+
+		errorCode, _, _ := mapLoginRejectError(err)
+		recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeFailed, utils.Ptr(errorCode))
+	}
+
+	if !loginRequest.Skip && loginHint != nil && *loginHint != "" {
+		hintUserId, err := uc.consumeLoginHint(ctx, *loginHint, input.LoginHintBinding, bot)
+		if err == nil {
+			attempt.userId = utils.Ptr(hintUserId)
+			err = uc.checkBotUser(ctx, bot, hintUserId)
+		}
+
+		if err == nil {
+			redirectUri, acceptErr := acceptLoginRequest(ctx, uc.hydra, loginRequest.Challenge, hintUserId)
+			if acceptErr == nil {
+				recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeSuccess, nil)
+				return uc.buildRedirectOutput(redirectUri), nil
+			}
+			err = acceptErr
+		}
+
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Str("login_challenge", challenge).
+			Str("client_id", clientId).
+			Msg("login hint login failed, falling back to interactive login UI")
+
+		errorCode, _, _ := mapLoginRejectError(err)
+		recordLoginEvent(ctx, uc.loginEventRepo, attempt, entity.LoginOutcomeFailed, utils.Ptr(errorCode))
 	}
 
-	return uc.buildRenderOutput(challenge, bot), nil
+	return uc.buildRenderOutput(ctx, challenge, bot), nil
 }
@@ -0,0 +1,156 @@
+//go:generate go-enum --values --names --nocase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	hydra "github.com/ory/hydra-client-go"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// ResolveLogoutChallenge handles a Hydra logout request. Logouts initiated by a relying party
+// are accepted right away, logouts started without an id_token_hint must be confirmed by the user
+// to prevent other sites from signing the user out. Once accepted, Hydra performs front-channel
+// and back-channel logout for every client of the session.
+type ResolveLogoutChallenge struct {
+	hydra *hydra.APIClient
+}
+
+func NewResolveLogoutChallenge(hydraClient *hydra.APIClient) (*ResolveLogoutChallenge, error) {
+	if hydraClient == nil {
+		return nil, errors.New("hydra client is nil")
+	}
+
+	return &ResolveLogoutChallenge{
+		hydra: hydraClient,
+	}, nil
+}
+
+type (
+	// ResolveLogoutChallengeAction enum for logout challenge handling action
+	// ENUM(
+	//     Redirect
+	//     Render
+	// )
+	ResolveLogoutChallengeAction string
+
+	ResolveLogoutChallengeInput struct {
+		LogoutChallenge string
+	}
+	ResolveLogoutChallengeOutput struct {
+		Action      ResolveLogoutChallengeAction
+		RedirectUri *string
+		ClientName  *string // Nil when the logout was not initiated by a client
+	}
+)
+
+func (uc *ResolveLogoutChallenge) verifyChallenge(challenge string) error {
+	if challenge == "" {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+	}
+	return nil
+}
+
+func (uc *ResolveLogoutChallenge) getLogoutRequest(ctx context.Context, logoutChallenge string) (*hydra.LogoutRequest, error) {
+	logoutRequest, resp, err := uc.hydra.AdminApi.
+		GetLogoutRequest(ctx).
+		LogoutChallenge(logoutChallenge).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusGone {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+
+	return logoutRequest, nil
+}
+
+func (uc *ResolveLogoutChallenge) acceptLogoutRequest(ctx context.Context, logoutChallenge string) (*ResolveLogoutChallengeOutput, error) {
+	completed, resp, err := uc.hydra.AdminApi.
+		AcceptLogoutRequest(ctx).
+		LogoutChallenge(logoutChallenge).
+		Execute()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewGatewayTimeoutErr("hydra")
+		}
+		if resp != nil {
+			if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("logout", "challenge", nil))
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return nil, NewBadGatewayErr("hydra")
+			}
+			return nil, ErrUnexpected
+		}
+		return nil, NewBadGatewayErr("hydra")
+	}
+
+	if completed == nil || completed.RedirectTo == "" {
+		return nil, ErrUnexpected
+	}
+
+	return &ResolveLogoutChallengeOutput{
+		Action:      ResolveLogoutChallengeActionRedirect,
+		RedirectUri: utils.Ptr(completed.RedirectTo),
+	}, nil
+}
+
+func (uc *ResolveLogoutChallenge) buildRenderOutput(logoutRequest *hydra.LogoutRequest) *ResolveLogoutChallengeOutput {
+	var clientName *string
+	if client := logoutRequest.Client; client != nil {
+		if client.ClientName != nil && *client.ClientName != "" {
+			clientName = client.ClientName
+		} else if client.ClientId != nil {
+			clientName = client.ClientId
+		}
+	}
+
+	return &ResolveLogoutChallengeOutput{
+		Action:     ResolveLogoutChallengeActionRender,
+		ClientName: clientName,
+	}
+}
+
+func (uc *ResolveLogoutChallenge) Execute(ctx context.Context, input *ResolveLogoutChallengeInput) (*ResolveLogoutChallengeOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	challenge := input.LogoutChallenge
+	if err := uc.verifyChallenge(challenge); err != nil {
+		return nil, err
+	}
+
+	logoutRequest, err := uc.getLogoutRequest(ctx, challenge)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Str("logout_challenge", challenge).
+			Msg("failed to get logout request from hydra")
+		return nil, err
+	}
+	if logoutRequest == nil {
+		return nil, ErrUnexpected
+	}
+
+	if logoutRequest.GetRpInitiated() {
+		return uc.acceptLogoutRequest(ctx, challenge)
+	}
+
+	return uc.buildRenderOutput(logoutRequest), nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package usecase
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ResolveLogoutChallengeActionRedirect is a ResolveLogoutChallengeAction of type Redirect.
+	ResolveLogoutChallengeActionRedirect ResolveLogoutChallengeAction = "Redirect"
+	// ResolveLogoutChallengeActionRender is a ResolveLogoutChallengeAction of type Render.
+	ResolveLogoutChallengeActionRender ResolveLogoutChallengeAction = "Render"
+)
+
+var ErrInvalidResolveLogoutChallengeAction = fmt.Errorf("not a valid ResolveLogoutChallengeAction, try [%s]", strings.Join(_ResolveLogoutChallengeActionNames, ", "))
+
+var _ResolveLogoutChallengeActionNames = []string{
+	string(ResolveLogoutChallengeActionRedirect),
+	string(ResolveLogoutChallengeActionRender),
+}
+
+// ResolveLogoutChallengeActionNames returns a list of possible string values of ResolveLogoutChallengeAction.
+func ResolveLogoutChallengeActionNames() []string {
+	tmp := make([]string, len(_ResolveLogoutChallengeActionNames))
+	copy(tmp, _ResolveLogoutChallengeActionNames)
+	return tmp
+}
+
+// ResolveLogoutChallengeActionValues returns a list of the values for ResolveLogoutChallengeAction
+func ResolveLogoutChallengeActionValues() []ResolveLogoutChallengeAction {
+	return []ResolveLogoutChallengeAction{
+		ResolveLogoutChallengeActionRedirect,
+		ResolveLogoutChallengeActionRender,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ResolveLogoutChallengeAction) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ResolveLogoutChallengeAction) IsValid() bool {
+	_, err := ParseResolveLogoutChallengeAction(string(x))
+	return err == nil
+}
+
+var _ResolveLogoutChallengeActionValue = map[string]ResolveLogoutChallengeAction{
+	"Redirect": ResolveLogoutChallengeActionRedirect,
+	"redirect": ResolveLogoutChallengeActionRedirect,
+	"Render":   ResolveLogoutChallengeActionRender,
+	"render":   ResolveLogoutChallengeActionRender,
+}
+
+// ParseResolveLogoutChallengeAction attempts to convert a string to a ResolveLogoutChallengeAction.
+func ParseResolveLogoutChallengeAction(name string) (ResolveLogoutChallengeAction, error) {
+	if x, ok := _ResolveLogoutChallengeActionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ResolveLogoutChallengeActionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ResolveLogoutChallengeAction(""), fmt.Errorf("%s is %w", name, ErrInvalidResolveLogoutChallengeAction)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// maxBotAccessPolicyUserIds limits the size of allow and deny lists; larger audiences belong in a chat.
+const maxBotAccessPolicyUserIds = 10000
+
+type SetBotAccessPolicy struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+	policyRepo repository.BotAccessPolicyRepositoryPort
+}
+
+func NewSetBotAccessPolicy(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+	policyRepo repository.BotAccessPolicyRepositoryPort,
+) (*SetBotAccessPolicy, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if policyRepo == nil {
+		return nil, errors.New("bot access policy repository is nil")
+	}
+
+	return &SetBotAccessPolicy{
+		transactor: transactor,
+		botRepo:    botRepo,
+		policyRepo: policyRepo,
+	}, nil
+}
+
+type (
+	SetBotAccessPolicyInput struct {
+		BotId            int64
+		AllowedUserIds   []int64
+		DeniedUserIds    []int64
+		RequireUsername  bool
+		RequirePremium   bool
+		AllowedLanguages []string
+	}
+	SetBotAccessPolicyOutput struct {
+		Policy *entity.BotAccessPolicy
+	}
+)
+
+func (uc *SetBotAccessPolicy) buildPolicy(input *SetBotAccessPolicyInput) (*entity.BotAccessPolicy, error) {
+	if len(input.AllowedUserIds) > maxBotAccessPolicyUserIds {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_access_policy", "allowed_user_ids", utils.Ptr("too many user ids")))
+	}
+	if len(input.DeniedUserIds) > maxBotAccessPolicyUserIds {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_access_policy", "denied_user_ids", utils.Ptr("too many user ids")))
+	}
+
+	policy, err := entity.NewBotAccessPolicy(
+		input.BotId,
+		input.AllowedUserIds,
+		input.DeniedUserIds,
+		input.RequireUsername,
+		input.RequirePremium,
+		input.AllowedLanguages,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("bot_access_policy", "data", nil), err)
+	}
+
+	return policy, nil
+}
+
+func (uc *SetBotAccessPolicy) Execute(ctx context.Context, input *SetBotAccessPolicyInput) (*SetBotAccessPolicyOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	policy, err := uc.buildPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+		if err != nil {
+			return fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+		}
+		if !exists {
+			return NewObjectNotFoundErr("bot", input.BotId)
+		}
+
+		if err := uc.policyRepo.Save(ctx, policy); err != nil {
+			return fmt.Errorf("%w: failed to save bot access policy", ErrUnexpected)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &SetBotAccessPolicyOutput{Policy: policy}, nil
+}
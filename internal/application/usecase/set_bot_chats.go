@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// maxBotChats limits the number of chats checked with getChatMember on every login.
+const maxBotChats = 16
+
+type SetBotChats struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+	chatRepo   repository.BotChatRepositoryPort
+}
+
+func NewSetBotChats(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+	chatRepo repository.BotChatRepositoryPort,
+) (*SetBotChats, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if chatRepo == nil {
+		return nil, errors.New("bot chat repository is nil")
+	}
+
+	return &SetBotChats{
+		transactor: transactor,
+		botRepo:    botRepo,
+		chatRepo:   chatRepo,
+	}, nil
+}
+
+type (
+	SetBotChatsItem struct {
+		ChatId int64
+		Group  string
+	}
+	SetBotChatsInput struct {
+		BotId int64
+		Chats []SetBotChatsItem
+	}
+	SetBotChatsOutput struct {
+		Chats []*entity.BotChat
+	}
+)
+
+func (uc *SetBotChats) buildChats(botId int64, items []SetBotChatsItem) ([]*entity.BotChat, error) {
+	if len(items) > maxBotChats {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_chat", "items", utils.Ptr("too many chats")))
+	}
+
+	seenChats := make(map[int64]struct{}, len(items))
+	seenGroups := make(map[string]struct{}, len(items))
+
+	chats := make([]*entity.BotChat, 0, len(items))
+	for i, item := range items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		chat, err := entity.NewBotChat(botId, item.ChatId, item.Group)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("bot_chat", field, nil), err)
+		}
+
+		if _, ok := seenChats[chat.ChatId]; ok {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_chat", field, utils.Ptr("duplicate chat")))
+		}
+		seenChats[chat.ChatId] = struct{}{}
+
+		if _, ok := seenGroups[chat.Group]; ok {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot_chat", field, utils.Ptr("duplicate group")))
+		}
+		seenGroups[chat.Group] = struct{}{}
+
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
+func (uc *SetBotChats) Execute(ctx context.Context, input *SetBotChatsInput) (*SetBotChatsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	chats, err := uc.buildChats(input.BotId, input.Chats)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+		if err != nil {
+			return fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+		}
+		if !exists {
+			return NewObjectNotFoundErr("bot", input.BotId)
+		}
+
+		if err := uc.chatRepo.ReplaceByBot(ctx, input.BotId, chats); err != nil {
+			return fmt.Errorf("%w: failed to replace bot chats", ErrUnexpected)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &SetBotChatsOutput{Chats: chats}, nil
+}
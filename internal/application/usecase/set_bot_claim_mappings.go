@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// maxBotClaimMappings limits the number of custom claims a bot can emit into id tokens.
+const maxBotClaimMappings = 64
+
+type SetBotClaimMappings struct {
+	transactor  service.Transactor
+	botRepo     repository.BotRepositoryPort
+	mappingRepo repository.BotClaimMappingRepositoryPort
+}
+
+func NewSetBotClaimMappings(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+	mappingRepo repository.BotClaimMappingRepositoryPort,
+) (*SetBotClaimMappings, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if mappingRepo == nil {
+		return nil, errors.New("bot claim mapping repository is nil")
+	}
+
+	return &SetBotClaimMappings{
+		transactor:  transactor,
+		botRepo:     botRepo,
+		mappingRepo: mappingRepo,
+	}, nil
+}
+
+type (
+	SetBotClaimMappingsItem struct {
+		Scope  string
+		Claim  string
+		Source string
+	}
+	SetBotClaimMappingsInput struct {
+		BotId    int64
+		Mappings []SetBotClaimMappingsItem
+	}
+	SetBotClaimMappingsOutput struct {
+		Mappings []*entity.BotClaimMapping
+	}
+)
+
+func (uc *SetBotClaimMappings) buildMappings(botId int64, items []SetBotClaimMappingsItem) ([]*entity.BotClaimMapping, error) {
+	if len(items) > maxBotClaimMappings {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("claim_mapping", "items", utils.Ptr("too many mappings")))
+	}
+
+	type mappingKey struct{ scope, claim string }
+	seen := make(map[mappingKey]struct{}, len(items))
+
+	mappings := make([]*entity.BotClaimMapping, 0, len(items))
+	for i, item := range items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		mapping, err := entity.NewBotClaimMapping(botId, item.Scope, item.Claim, entity.ClaimSource(item.Source))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("claim_mapping", field, nil), err)
+		}
+
+		key := mappingKey{scope: mapping.Scope, claim: mapping.Claim}
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("claim_mapping", field, utils.Ptr("duplicate scope and claim")))
+		}
+		seen[key] = struct{}{}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
+
+func (uc *SetBotClaimMappings) Execute(ctx context.Context, input *SetBotClaimMappingsInput) (*SetBotClaimMappingsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	mappings, err := uc.buildMappings(input.BotId, input.Mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		exists, err := uc.botRepo.ExistsByID(ctx, input.BotId)
+		if err != nil {
+			return fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
+		}
+		if !exists {
+			return NewObjectNotFoundErr("bot", input.BotId)
+		}
+
+		if err := uc.mappingRepo.ReplaceByBot(ctx, input.BotId, mappings); err != nil {
+			return fmt.Errorf("%w: failed to replace bot claim mappings", ErrUnexpected)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &SetBotClaimMappingsOutput{Mappings: mappings}, nil
+}
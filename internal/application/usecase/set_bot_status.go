@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type SetBotStatus struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+}
+
+func NewSetBotStatus(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+) (*SetBotStatus, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+
+	return &SetBotStatus{
+		transactor: transactor,
+		botRepo:    botRepo,
+	}, nil
+}
+
+type (
+	SetBotStatusInput struct {
+		BotId  int64
+		Status entity.BotStatus
+		Reason *string // Shown to users whose logins are rejected
+	}
+	SetBotStatusOutput struct {
+		Bot     *entity.Bot
+		Updated bool
+	}
+)
+
+func (uc *SetBotStatus) setStatus(ctx context.Context, input *SetBotStatusInput) (*entity.Bot, bool, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, input.BotId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, NewObjectNotFoundErr("bot", input.BotId)
+		}
+		return nil, false, fmt.Errorf("%w: failed to get bot by ID", ErrUnexpected)
+	}
+	beforeTouch := bot.ModifiedAt()
+
+	if err := bot.SetStatus(input.Status, input.Reason); err != nil {
+		return nil, false, fmt.Errorf("%w: %w: %v", ErrInvalidInput, NewObjectInvalidErr("bot", "status", nil), err)
+	}
+	if !bot.ModifiedAt().After(beforeTouch) {
+		return &bot, false, nil
+	}
+
+	if err := uc.botRepo.Update(ctx, &bot); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) {
+			return nil, false, NewConflictErr("bot", nil)
+		}
+		return nil, false, fmt.Errorf("%w: failed to update bot", ErrUnexpected)
+	}
+	return &bot, true, nil
+}
+
+func (uc *SetBotStatus) Execute(ctx context.Context, input *SetBotStatusInput) (*SetBotStatusOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var output SetBotStatusOutput
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		bot, updated, err := uc.setStatus(ctx, input)
+		if err != nil {
+			return err
+		}
+		output.Bot = bot
+		output.Updated = updated
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if output.Updated {
+		event := zerolog.Ctx(ctx).Info()
+		if output.Bot.Status != entity.BotStatusActive {
+			event = zerolog.Ctx(ctx).Warn()
+		}
+		event.
+			Int64("bot_id", output.Bot.Id).
+			Str("status", string(output.Bot.Status)).
+			Interface("reason", output.Bot.StatusReason).
+			Msg("bot status changed")
+	}
+
+	return &output, nil
+}
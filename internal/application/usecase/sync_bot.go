@@ -5,8 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
@@ -16,13 +21,26 @@ import (
 type SyncBot struct {
 	transactor    service.Transactor
 	botRepo       repository.BotRepositoryPort
+	botPhotoRepo  repository.BotPhotoRepositoryPort
 	tokenVerifier service.TelegramTokenVerifier
+
+	webhook         service.TelegramBotWebhook
+	webhookBaseUri  *url.URL
+	registerWebhook bool
+
+	rateLimiter *routeRateLimiter
 }
 
 func NewSyncBot(
 	transactor service.Transactor,
 	botRepo repository.BotRepositoryPort,
+	botPhotoRepo repository.BotPhotoRepositoryPort,
 	tokenVerifier service.TelegramTokenVerifier,
+	webhook service.TelegramBotWebhook,
+	webhookBaseUri *url.URL,
+	registerWebhook bool,
+	rateLimiter service.RateLimiter,
+	rateLimits RateLimits,
 ) (*SyncBot, error) {
 	if transactor == nil {
 		return nil, errors.New("transactor is nil")
@@ -30,14 +48,31 @@ func NewSyncBot(
 	if botRepo == nil {
 		return nil, errors.New("bot repository is nil")
 	}
+	if botPhotoRepo == nil {
+		return nil, errors.New("bot photo repository is nil")
+	}
 	if tokenVerifier == nil {
 		return nil, errors.New("telegram token verifier is nil")
 	}
+	if webhook == nil {
+		return nil, errors.New("bot webhook is nil")
+	}
+	if webhookBaseUri == nil {
+		return nil, errors.New("webhook base URI is nil")
+	}
+	if rateLimiter == nil {
+		return nil, errors.New("rate limiter is nil")
+	}
 
 	return &SyncBot{
-		transactor:    transactor,
-		botRepo:       botRepo,
-		tokenVerifier: tokenVerifier,
+		transactor:      transactor,
+		botRepo:         botRepo,
+		botPhotoRepo:    botPhotoRepo,
+		tokenVerifier:   tokenVerifier,
+		webhook:         webhook,
+		webhookBaseUri:  webhookBaseUri,
+		registerWebhook: registerWebhook,
+		rateLimiter:     newRouteRateLimiter(rateLimiter, "create_bot", rateLimits),
 	}, nil
 }
 
@@ -51,7 +86,9 @@ type (
 	SyncBotStatus string
 
 	SyncBotInput struct {
-		BotToken string
+		BotToken             string
+		InitDataVerification *entity.BotInitDataVerification
+		ClientIP             netip.Addr // Zero when unknown, e.g. on a unix socket
 	}
 	SyncBotOutput struct {
 		Id           int64
@@ -60,8 +97,27 @@ type (
 	}
 )
 
+// checkRateLimits limits syncs per client and per bot before Telegram is called. The bot id is
+// taken from the token prefix; malformed tokens are left to the token verifier.
+func (uc *SyncBot) checkRateLimits(ctx context.Context, input *SyncBotInput) error {
+	if err := uc.rateLimiter.checkIP(ctx, input.ClientIP); err != nil {
+		return err
+	}
+
+	rawBotId, _, found := strings.Cut(input.BotToken, ":")
+	if !found {
+		return nil
+	}
+	botId, err := strconv.ParseInt(rawBotId, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return uc.rateLimiter.checkBot(ctx, botId)
+}
+
 func (uc *SyncBot) verifyBotToken(ctx context.Context, botToken string) (*service.TelegramBotInfo, error) {
-	if botInfo, err := uc.tokenVerifier.Verify(ctx, botToken, service.NewVerifyOptions(service.WithSkipCacheRead())); err != nil {
+	opts := service.NewVerifyOptions(service.WithSkipCacheRead(), service.WithProfile())
+	if botInfo, err := uc.tokenVerifier.Verify(ctx, botToken, opts); err != nil {
 		if errors.Is(err, service.ErrTelegramBotTokenMalformed) {
 			return nil, fmt.Errorf(
 				"%w: %w",
@@ -80,24 +136,101 @@ func (uc *SyncBot) verifyBotToken(ctx context.Context, botToken string) (*servic
 	}
 }
 
-func (uc *SyncBot) createBot(ctx context.Context, botInfo *service.TelegramBotInfo, botToken string) (*entity.Bot, error) {
+func (uc *SyncBot) applyInitDataVerification(bot *entity.Bot, verification *entity.BotInitDataVerification) error {
+	if verification == nil {
+		return nil
+	}
+	if err := bot.SetInitDataVerification(*verification); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, NewObjectInvalidErr("bot", "init_data_verification", nil))
+	}
+	return nil
+}
+
+// applyProfile applies the profile fetched from Telegram to the bot and returns the new profile photo
+// to store, if it changed. The stored profile is kept when Telegram did not return one.
+func (uc *SyncBot) applyProfile(ctx context.Context, bot *entity.Bot, botInfo *service.TelegramBotInfo) (*entity.BotPhoto, error) {
+	bot.SetCapabilities(botInfo.CanJoinGroups, botInfo.SupportsInlineQueries)
+
+	profile := botInfo.Profile
+	if profile == nil {
+		return nil, nil
+	}
+	if err := bot.SetDescriptions(profile.Description, profile.ShortDescription); err != nil {
+		return nil, fmt.Errorf("%w: %v", NewObjectInvalidErr("bot", "description", nil), err)
+	}
+
+	if profile.Photo != nil && bot.PhotoFileUniqueId != nil && *bot.PhotoFileUniqueId == profile.Photo.FileUniqueId {
+		return nil, nil
+	}
+
+	var (
+		photoFileUniqueId *string
+		photo             *entity.BotPhoto
+	)
+	if profile.Photo != nil {
+		botPhoto, err := entity.NewBotPhoto(bot.Id, profile.Photo.FileUniqueId, profile.Photo.Content, profile.Photo.ContentType)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Int64("bot_id", bot.Id).Msg("ignoring invalid bot profile photo")
+		} else {
+			photoFileUniqueId = utils.Ptr(botPhoto.FileUniqueId)
+			photo = botPhoto
+		}
+	}
+	if err := bot.SetPhotoFileUniqueId(photoFileUniqueId); err != nil {
+		return nil, fmt.Errorf("%w: %v", NewObjectInvalidErr("bot", "photo", nil), err)
+	}
+
+	return photo, nil
+}
+
+// storePhoto stores the new profile photo of a persisted bot, or removes the old one when the bot has no photo.
+func (uc *SyncBot) storePhoto(ctx context.Context, bot *entity.Bot, photo *entity.BotPhoto) error {
+	if bot.PhotoFileUniqueId == nil {
+		if err := uc.botPhotoRepo.DeleteByBot(ctx, bot.Id); err != nil {
+			return fmt.Errorf("%w: failed to delete bot photo", ErrUnexpected)
+		}
+		return nil
+	}
+	if photo == nil {
+		return nil
+	}
+	if err := uc.botPhotoRepo.Save(ctx, photo); err != nil {
+		return fmt.Errorf("%w: failed to save bot photo", ErrUnexpected)
+	}
+	return nil
+}
+
+func (uc *SyncBot) createBot(ctx context.Context, botInfo *service.TelegramBotInfo, input *SyncBotInput) (*entity.Bot, error) {
 	if botInfo == nil {
 		return nil, errors.New("bot info is nil")
 	}
 
-	bot, err := entity.NewBot(botInfo.Id, botInfo.Name, botInfo.Username, botToken)
+	bot, err := entity.NewBot(botInfo.Id, botInfo.Name, botInfo.Username, input.BotToken)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create bot entity", ErrUnexpected)
 	}
+	if err := uc.applyInitDataVerification(bot, input.InitDataVerification); err != nil {
+		return nil, err
+	}
+	if err := bot.SetCredentialsStatus(entity.BotCredentialsStatusValid, time.Now()); err != nil {
+		return nil, fmt.Errorf("%w: failed to set bot credentials status", ErrUnexpected)
+	}
+	photo, err := uc.applyProfile(ctx, bot, botInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := uc.botRepo.Create(ctx, bot); err != nil {
 		return nil, fmt.Errorf("%w: failed to create bot", ErrUnexpected)
 	}
+	if err := uc.storePhoto(ctx, bot, photo); err != nil {
+		return nil, err
+	}
 
 	return bot, nil
 }
 
-func (uc *SyncBot) updateBot(ctx context.Context, botInfo *service.TelegramBotInfo, botToken string) (*entity.Bot, bool, error) {
+func (uc *SyncBot) updateBot(ctx context.Context, botInfo *service.TelegramBotInfo, input *SyncBotInput) (*entity.Bot, bool, error) {
 	if botInfo == nil {
 		return nil, false, errors.New("bot info is nil")
 	}
@@ -114,28 +247,56 @@ func (uc *SyncBot) updateBot(ctx context.Context, botInfo *service.TelegramBotIn
 	if err := bot.SetUsername(botInfo.Username); err != nil {
 		return nil, false, fmt.Errorf("%w: %v", NewObjectInvalidErr("bot", "username", nil), err)
 	}
-	if err := bot.SetToken(botToken); err != nil {
+	if err := bot.SetToken(input.BotToken); err != nil {
 		return nil, false, fmt.Errorf("%w: %v", NewObjectInvalidErr("bot", "token", nil), err)
 	}
+	if err := uc.applyInitDataVerification(&bot, input.InitDataVerification); err != nil {
+		return nil, false, err
+	}
+	photo, err := uc.applyProfile(ctx, &bot, botInfo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The token has just been accepted by Telegram, so logins to a bot with revoked credentials are restored
+	restoreCredentials := !bot.HasValidCredentials()
+	if restoreCredentials {
+		bot.Touch()
+	}
 
 	afterTouch := bot.ModifiedAt()
 	if afterTouch.After(beforeTouch) {
 		if err := uc.botRepo.Update(ctx, &bot); err != nil {
+			if errors.Is(err, repository.ErrConcurrentModification) {
+				return nil, false, NewConflictErr("bot", nil)
+			}
 			return nil, false, fmt.Errorf("%w: failed to update bot", ErrUnexpected)
 		}
+		// Update does not store the credentials status
+		if restoreCredentials {
+			if err := bot.SetCredentialsStatus(entity.BotCredentialsStatusValid, time.Now()); err != nil {
+				return nil, false, fmt.Errorf("%w: failed to set bot credentials status", ErrUnexpected)
+			}
+			if err := uc.botRepo.UpdateCredentialsStatus(ctx, &bot); err != nil {
+				return nil, false, fmt.Errorf("%w: failed to update bot credentials status", ErrUnexpected)
+			}
+		}
+		if err := uc.storePhoto(ctx, &bot, photo); err != nil {
+			return nil, false, err
+		}
 		return &bot, true, nil
 	} else {
 		return &bot, false, nil
 	}
 }
 
-func (uc *SyncBot) upsertBot(ctx context.Context, botInfo *service.TelegramBotInfo, botToken string) (*entity.Bot, SyncBotStatus, error) {
+func (uc *SyncBot) upsertBot(ctx context.Context, botInfo *service.TelegramBotInfo, input *SyncBotInput) (*entity.Bot, SyncBotStatus, error) {
 	exists, err := uc.botRepo.ExistsByID(ctx, botInfo.Id)
 	if err != nil {
 		return nil, "", fmt.Errorf("%w: failed to check bot existence", ErrUnexpected)
 	}
 	if exists {
-		bot, updated, err := uc.updateBot(ctx, botInfo, botToken)
+		bot, updated, err := uc.updateBot(ctx, botInfo, input)
 		if err != nil {
 			return nil, "", err
 		}
@@ -144,7 +305,7 @@ func (uc *SyncBot) upsertBot(ctx context.Context, botInfo *service.TelegramBotIn
 		}
 		return bot, SyncBotStatusNotUpdated, nil
 	} else {
-		bot, err := uc.createBot(ctx, botInfo, botToken)
+		bot, err := uc.createBot(ctx, botInfo, input)
 		if err != nil {
 			return nil, "", err
 		}
@@ -152,11 +313,31 @@ func (uc *SyncBot) upsertBot(ctx context.Context, botInfo *service.TelegramBotIn
 	}
 }
 
+// registerBotWebhook points the bot webhook to the provider so that bot logins can be confirmed.
+// Failures are not fatal: the bot is already stored and the webhook can be registered on the next sync.
+func (uc *SyncBot) registerBotWebhook(ctx context.Context, botId int64, botToken string) {
+	if !uc.registerWebhook {
+		return
+	}
+
+	webhookUri := uc.webhookBaseUri.JoinPath("/telegram/webhook", strconv.FormatInt(botId, 10))
+	if err := uc.webhook.Register(ctx, botToken, botId, webhookUri.String()); err != nil {
+		zerolog.Ctx(ctx).Warn().
+			Err(err).
+			Int64("bot_id", botId).
+			Msg("failed to register bot webhook, bot login will not be available")
+	}
+}
+
 func (uc *SyncBot) Execute(ctx context.Context, input *SyncBotInput) (*SyncBotOutput, error) {
 	if input == nil {
 		return nil, errors.New("input is nil")
 	}
 
+	if err := uc.checkRateLimits(ctx, input); err != nil {
+		return nil, err
+	}
+
 	botInfo, err := uc.verifyBotToken(ctx, input.BotToken)
 	if err != nil {
 		return nil, err
@@ -164,7 +345,7 @@ func (uc *SyncBot) Execute(ctx context.Context, input *SyncBotInput) (*SyncBotOu
 
 	var output SyncBotOutput
 	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
-		bot, status, err := uc.upsertBot(ctx, botInfo, input.BotToken)
+		bot, status, err := uc.upsertBot(ctx, botInfo, input)
 		if err != nil {
 			return err
 		}
@@ -176,5 +357,7 @@ func (uc *SyncBot) Execute(ctx context.Context, input *SyncBotInput) (*SyncBotOu
 		return nil, err
 	}
 
+	uc.registerBotWebhook(ctx, output.Id, input.BotToken)
+
 	return &output, nil
 }
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+)
+
+type UnlinkBotFromClient struct {
+	transactor service.Transactor
+	botRepo    repository.BotRepositoryPort
+}
+
+func NewUnlinkBotFromClient(
+	transactor service.Transactor,
+	botRepo repository.BotRepositoryPort,
+) (*UnlinkBotFromClient, error) {
+	if transactor == nil {
+		return nil, errors.New("transactor is nil")
+	}
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+
+	return &UnlinkBotFromClient{
+		transactor: transactor,
+		botRepo:    botRepo,
+	}, nil
+}
+
+type (
+	UnlinkBotFromClientInput struct {
+		BotId int64
+	}
+	UnlinkBotFromClientOutput struct {
+		Updated bool
+	}
+)
+
+func (uc *UnlinkBotFromClient) unlinkBot(ctx context.Context, botId int64) (bool, error) {
+	var bot entity.Bot
+	if err := uc.botRepo.GetByID(ctx, botId, &bot); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, NewObjectNotFoundErr("bot", botId)
+		}
+		return false, fmt.Errorf("%w: failed to get bot by ID", ErrUnexpected)
+	}
+
+	if bot.ClientId == nil {
+		return false, nil
+	}
+	if err := bot.SetClientId(nil); err != nil {
+		return false, fmt.Errorf("%w: failed to unset client ID", ErrUnexpected)
+	}
+
+	if err := uc.botRepo.Update(ctx, &bot); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) {
+			return false, NewConflictErr("bot", nil)
+		}
+		return false, fmt.Errorf("%w: failed to update bot", ErrUnexpected)
+	}
+	return true, nil
+}
+
+func (uc *UnlinkBotFromClient) Execute(ctx context.Context, input *UnlinkBotFromClientInput) (*UnlinkBotFromClientOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var output UnlinkBotFromClientOutput
+	if err := uc.transactor.RunInTransaction(ctx, func(ctx context.Context) error {
+		updated, err := uc.unlinkBot(ctx, input.BotId)
+		if err != nil {
+			return err
+		}
+		output.Updated = updated
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
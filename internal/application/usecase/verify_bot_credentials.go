@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// verifyBotCredentialsBatchSize is the number of bots loaded per page.
+const verifyBotCredentialsBatchSize = 100
+
+// VerifyBotCredentials checks every stored bot token with Telegram and records whether it is still accepted,
+// so that logins to bots with revoked tokens are rejected early.
+type VerifyBotCredentials struct {
+	botRepo       repository.BotRepositoryPort
+	tokenVerifier service.TelegramTokenVerifier
+}
+
+func NewVerifyBotCredentials(
+	botRepo repository.BotRepositoryPort,
+	tokenVerifier service.TelegramTokenVerifier,
+) (*VerifyBotCredentials, error) {
+	if botRepo == nil {
+		return nil, errors.New("bot repository is nil")
+	}
+	if tokenVerifier == nil {
+		return nil, errors.New("telegram token verifier is nil")
+	}
+
+	return &VerifyBotCredentials{
+		botRepo:       botRepo,
+		tokenVerifier: tokenVerifier,
+	}, nil
+}
+
+type (
+	VerifyBotCredentialsInput  struct{}
+	VerifyBotCredentialsOutput struct {
+		Checked int // Bots whose status was updated
+		Invalid int // Bots with rejected tokens
+		Skipped int // Bots that could not be checked, e.g. because Telegram is unavailable
+	}
+)
+
+// checkToken returns the credentials status of the token. Tokens are checked with Telegram
+// even when cached, and the result is cached for the logins.
+func (uc *VerifyBotCredentials) checkToken(ctx context.Context, token string) (entity.BotCredentialsStatus, error) {
+	_, err := uc.tokenVerifier.Verify(ctx, token, service.NewVerifyOptions(service.WithSkipCacheRead()))
+	if err != nil {
+		if errors.Is(err, service.ErrTelegramBotTokenInvalid) || errors.Is(err, service.ErrTelegramBotTokenMalformed) {
+			return entity.BotCredentialsStatusInvalid, nil
+		}
+		return "", err
+	}
+	return entity.BotCredentialsStatusValid, nil
+}
+
+// verifyBot checks the token of a bot and stores the result. Returns false when the bot was not checked.
+func (uc *VerifyBotCredentials) verifyBot(ctx context.Context, bot *entity.Bot) bool {
+	logger := zerolog.Ctx(ctx).With().Int64("bot_id", bot.Id).Str("bot_username", bot.Username).Logger()
+
+	botToken, err := bot.Token()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load bot token")
+		return false
+	}
+
+	status, err := uc.checkToken(ctx, botToken)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to verify bot credentials")
+		return false
+	}
+
+	previous := bot.CredentialsStatus
+	if err := bot.SetCredentialsStatus(status, time.Now()); err != nil {
+		logger.Error().Err(err).Msg("failed to set bot credentials status")
+		return false
+	}
+
+	if err := uc.botRepo.UpdateCredentialsStatus(ctx, bot); err != nil {
+		if errors.Is(err, repository.ErrConcurrentModification) || errors.Is(err, repository.ErrNotFound) {
+			// The bot was updated or deleted meanwhile, the status will be refreshed on the next run
+			logger.Debug().Err(err).Msg("bot changed during credentials verification")
+		} else {
+			logger.Error().Err(err).Msg("failed to update bot credentials status")
+		}
+		return false
+	}
+
+	switch {
+	case status == entity.BotCredentialsStatusInvalid && previous != status:
+		logger.Warn().Msg("bot credentials were revoked, logins are rejected until the bot token is updated")
+	case status == entity.BotCredentialsStatusValid && previous == entity.BotCredentialsStatusInvalid:
+		logger.Info().Msg("bot credentials are valid again")
+	}
+
+	return true
+}
+
+func (uc *VerifyBotCredentials) Execute(ctx context.Context, input *VerifyBotCredentialsInput) (*VerifyBotCredentialsOutput, error) {
+	if input == nil {
+		return nil, errors.New("input is nil")
+	}
+
+	var (
+		output VerifyBotCredentialsOutput
+		lastId *int64
+	)
+	for {
+		bots, err := uc.botRepo.List(ctx, &repository.BotListFilter{
+			AfterId: lastId,
+			Limit:   verifyBotCredentialsBatchSize,
+		})
+		if err != nil {
+			return &output, fmt.Errorf("%w: failed to list bots", ErrUnexpected)
+		}
+		if len(bots) == 0 {
+			return &output, nil
+		}
+
+		for _, bot := range bots {
+			if err := ctx.Err(); err != nil {
+				return &output, err
+			}
+
+			lastId = utils.Ptr(bot.Id)
+			if !uc.verifyBot(ctx, bot) {
+				output.Skipped++
+				continue
+			}
+			output.Checked++
+			if !bot.HasValidCredentials() {
+				output.Invalid++
+			}
+		}
+	}
+}
@@ -2,15 +2,64 @@ package entity
 
 import "time"
 
+// BotInitDataVerification defines how Mini App init data of a bot is verified.
+type BotInitDataVerification string
+
+const (
+	// BotInitDataVerificationHash verifies init data with the HMAC-SHA256 hash derived from the bot token.
+	BotInitDataVerificationHash BotInitDataVerification = "hash"
+	// BotInitDataVerificationSignature verifies init data with Telegram's Ed25519 third-party signature.
+	BotInitDataVerificationSignature BotInitDataVerification = "signature"
+)
+
+// BotStatus defines whether a bot serves logins.
+type BotStatus string
+
+const (
+	// BotStatusActive means the bot serves logins.
+	BotStatusActive BotStatus = "active"
+	// BotStatusDisabled means logins are turned off until the bot is activated again.
+	BotStatusDisabled BotStatus = "disabled"
+	// BotStatusMaintenance means logins are temporarily unavailable, e.g. during an incident.
+	BotStatusMaintenance BotStatus = "maintenance"
+)
+
+// BotCredentialsStatus defines whether Telegram accepts the stored bot token.
+type BotCredentialsStatus string
+
+const (
+	// BotCredentialsStatusValid means the token was accepted by Telegram on the last check.
+	BotCredentialsStatusValid BotCredentialsStatus = "valid"
+	// BotCredentialsStatusInvalid means Telegram rejected the token, e.g. because it was revoked in BotFather.
+	BotCredentialsStatusInvalid BotCredentialsStatus = "credentials_invalid"
+)
+
 // Bot represents a Telegram bot.
 type Bot struct {
-	Id        int64
-	Name      string
-	ClientId  *string
-	Username  string
-	Token     string
-	CreatedAt time.Time
-	UpdatedAt *time.Time
+	Id                   int64
+	Name                 string
+	ClientId             *string
+	Username             string
+	InitDataVerification BotInitDataVerification
+
+	token       string
+	tokenLoader func() (string, error) // Resolves the stored token on first use, nil once it is loaded
+
+	// Profile synced from Telegram
+	Description           string
+	ShortDescription      string
+	CanJoinGroups         bool
+	SupportsInlineQueries bool
+	PhotoFileUniqueId     *string // Identifies the stored profile photo, nil when the bot has none
+
+	Status               BotStatus
+	StatusReason         *string // Shown to users whose logins are rejected
+	StatusChangedAt      *time.Time
+	CredentialsStatus    BotCredentialsStatus
+	CredentialsCheckedAt *time.Time
+	Version              int64 // Incremented on every update, used for optimistic concurrency control
+	CreatedAt            time.Time
+	UpdatedAt            *time.Time
 }
 
 func NewBot(id int64, name string, username string, token string) (*Bot, error) {
@@ -24,11 +73,15 @@ func NewBot(id int64, name string, username string, token string) (*Bot, error)
 		return nil, err
 	}
 	return &Bot{
-		Id:        id,
-		Name:      name,
-		Username:  username,
-		Token:     token,
-		CreatedAt: time.Now(),
+		Id:                   id,
+		Name:                 name,
+		Username:             username,
+		token:                token,
+		InitDataVerification: BotInitDataVerificationHash,
+		Status:               BotStatusActive,
+		CredentialsStatus:    BotCredentialsStatusValid,
+		Version:              1,
+		CreatedAt:            time.Now(),
 	}, nil
 }
 
@@ -68,14 +121,41 @@ func (b *Bot) SetUsername(username string) error {
 	return nil
 }
 
+// Token returns the bot token, loading it from storage on the first call.
+func (b *Bot) Token() (string, error) {
+	if b.tokenLoader != nil {
+		token, err := b.tokenLoader()
+		if err != nil {
+			return "", err
+		}
+		b.token = token
+		b.tokenLoader = nil
+	}
+	return b.token, nil
+}
+
+// IsTokenLoaded reports whether the token is held in memory, either set or already loaded.
+func (b *Bot) IsTokenLoaded() bool {
+	return b.tokenLoader == nil
+}
+
+// SetTokenLoader defers loading the stored token until Token is called, so that bots read only
+// for their profile or configuration never decrypt it. Intended for repositories.
+func (b *Bot) SetTokenLoader(loader func() (string, error)) {
+	b.token = ""
+	b.tokenLoader = loader
+}
+
 func (b *Bot) SetToken(token string) error {
 	if err := validateBotToken(token); err != nil {
 		return err
 	}
-	if b.Token == token {
+	// A stored token that cannot be loaded is replaced as well
+	if current, err := b.Token(); err == nil && current == token {
 		return nil
 	}
-	b.Token = token
+	b.token = token
+	b.tokenLoader = nil
 	b.Touch()
 	return nil
 }
@@ -86,10 +166,102 @@ func (b *Bot) SetClientId(clientId *string) error {
 			return err
 		}
 	}
-	if b.ClientId == clientId {
+	if b.ClientId == clientId || (b.ClientId != nil && clientId != nil && *b.ClientId == *clientId) {
 		return nil
 	}
 	b.ClientId = clientId
 	b.Touch()
 	return nil
 }
+
+func (b *Bot) SetInitDataVerification(verification BotInitDataVerification) error {
+	if err := validateBotInitDataVerification(verification); err != nil {
+		return err
+	}
+	if b.InitDataVerification == verification {
+		return nil
+	}
+	b.InitDataVerification = verification
+	b.Touch()
+	return nil
+}
+
+// SetDescriptions sets the description and the short description shown in the bot profile.
+func (b *Bot) SetDescriptions(description string, shortDescription string) error {
+	if err := validateBotDescription(description, maxBotDescriptionLength); err != nil {
+		return err
+	}
+	if err := validateBotDescription(shortDescription, maxBotShortDescriptionLength); err != nil {
+		return err
+	}
+	if b.Description == description && b.ShortDescription == shortDescription {
+		return nil
+	}
+	b.Description = description
+	b.ShortDescription = shortDescription
+	b.Touch()
+	return nil
+}
+
+// SetCapabilities sets the capabilities reported by Telegram for the bot.
+func (b *Bot) SetCapabilities(canJoinGroups bool, supportsInlineQueries bool) {
+	if b.CanJoinGroups == canJoinGroups && b.SupportsInlineQueries == supportsInlineQueries {
+		return
+	}
+	b.CanJoinGroups = canJoinGroups
+	b.SupportsInlineQueries = supportsInlineQueries
+	b.Touch()
+}
+
+// SetPhotoFileUniqueId sets the unique id of the current profile photo, nil when the bot has none.
+func (b *Bot) SetPhotoFileUniqueId(fileUniqueId *string) error {
+	if fileUniqueId != nil {
+		if err := validateFileUniqueId(*fileUniqueId); err != nil {
+			return err
+		}
+	}
+	if b.PhotoFileUniqueId == fileUniqueId || (b.PhotoFileUniqueId != nil && fileUniqueId != nil && *b.PhotoFileUniqueId == *fileUniqueId) {
+		return nil
+	}
+	b.PhotoFileUniqueId = fileUniqueId
+	b.Touch()
+	return nil
+}
+
+// SetStatus changes the lifecycle status of the bot along with the reason of the change.
+func (b *Bot) SetStatus(status BotStatus, reason *string) error {
+	if err := validateBotStatus(status); err != nil {
+		return err
+	}
+	if reason != nil {
+		if err := validateBotStatusReason(*reason); err != nil {
+			return err
+		}
+	}
+	sameReason := b.StatusReason == reason || (b.StatusReason != nil && reason != nil && *b.StatusReason == *reason)
+	if b.Status == status && sameReason {
+		return nil
+	}
+	now := time.Now()
+	b.Status = status
+	b.StatusReason = reason
+	b.StatusChangedAt = &now
+	b.Touch()
+	return nil
+}
+
+// HasValidCredentials reports whether the bot token was accepted by Telegram on the last check.
+func (b *Bot) HasValidCredentials() bool {
+	return b.CredentialsStatus != BotCredentialsStatusInvalid
+}
+
+// SetCredentialsStatus records the result of a token check. The bot is not touched,
+// since a check does not change the bot itself.
+func (b *Bot) SetCredentialsStatus(status BotCredentialsStatus, checkedAt time.Time) error {
+	if err := validateBotCredentialsStatus(status); err != nil {
+		return err
+	}
+	b.CredentialsStatus = status
+	b.CredentialsCheckedAt = &checkedAt
+	return nil
+}
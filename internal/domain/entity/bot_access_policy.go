@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrAccessDenied is returned when a Telegram user does not satisfy the access policy of a bot.
+var ErrAccessDenied = errors.New("access denied")
+
+// BotAccessPolicy restricts which Telegram users may sign in to the clients of a bot.
+// A bot without a policy admits every Telegram user. Chat memberships are required through BotChat.
+type BotAccessPolicy struct {
+	BotId            int64
+	AllowedUserIds   []int64  // When not empty, only these users are admitted
+	DeniedUserIds    []int64  // Never admitted, takes precedence over AllowedUserIds
+	RequireUsername  bool     // The user must have a public username
+	RequirePremium   bool     // The user must have Telegram Premium
+	AllowedLanguages []string // When not empty, the user language must match one of these codes
+
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func NewBotAccessPolicy(
+	botId int64,
+	allowedUserIds []int64,
+	deniedUserIds []int64,
+	requireUsername bool,
+	requirePremium bool,
+	allowedLanguages []string,
+) (*BotAccessPolicy, error) {
+	if err := validateBotId(botId); err != nil {
+		return nil, err
+	}
+
+	allowed, err := normalizeUserIds(allowedUserIds)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := normalizeUserIds(deniedUserIds)
+	if err != nil {
+		return nil, err
+	}
+	languages, err := normalizeLanguageCodes(allowedLanguages)
+	if err != nil {
+		return nil, err
+	}
+	return &BotAccessPolicy{
+		BotId:            botId,
+		AllowedUserIds:   allowed,
+		DeniedUserIds:    denied,
+		RequireUsername:  requireUsername,
+		RequirePremium:   requirePremium,
+		AllowedLanguages: languages,
+		CreatedAt:        time.Now(),
+	}, nil
+}
+
+// Evaluate checks the Telegram user against the policy. Language is the user's language code, if known.
+func (p *BotAccessPolicy) Evaluate(userId int64, user *User, language *string) error {
+	if slices.Contains(p.DeniedUserIds, userId) {
+		return fmt.Errorf("user is denylisted: %w", ErrAccessDenied)
+	}
+	if len(p.AllowedUserIds) > 0 && !slices.Contains(p.AllowedUserIds, userId) {
+		return fmt.Errorf("user is not allowlisted: %w", ErrAccessDenied)
+	}
+	if p.RequireUsername && (user == nil || user.Username == nil || *user.Username == "") {
+		return fmt.Errorf("user has no username: %w", ErrAccessDenied)
+	}
+	if p.RequirePremium && (user == nil || user.IsPremium == nil || !*user.IsPremium) {
+		return fmt.Errorf("user has no Telegram Premium: %w", ErrAccessDenied)
+	}
+	if len(p.AllowedLanguages) > 0 && !p.languageAllowed(language) {
+		return fmt.Errorf("user language is not allowed: %w", ErrAccessDenied)
+	}
+	return nil
+}
+
+// languageAllowed matches the language exactly or by its primary subtag, so "en" admits "en-US".
+func (p *BotAccessPolicy) languageAllowed(language *string) bool {
+	if language == nil || *language == "" {
+		return false
+	}
+	code := strings.ToLower(*language)
+	primary, _, _ := strings.Cut(code, "-")
+	return slices.Contains(p.AllowedLanguages, code) || slices.Contains(p.AllowedLanguages, primary)
+}
+
+func normalizeUserIds(userIds []int64) ([]int64, error) {
+	normalized := make([]int64, 0, len(userIds))
+	for _, userId := range userIds {
+		if err := validateUserId(userId); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(normalized, userId) {
+			normalized = append(normalized, userId)
+		}
+	}
+	return normalized, nil
+}
+
+func normalizeLanguageCodes(languages []string) ([]string, error) {
+	normalized := make([]string, 0, len(languages))
+	for _, language := range languages {
+		language = strings.ToLower(language)
+		if err := validateLanguageCode(language); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(normalized, language) {
+			normalized = append(normalized, language)
+		}
+	}
+	return normalized, nil
+}
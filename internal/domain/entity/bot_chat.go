@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// BotChat binds a Telegram group or channel to a bot. When a bot has chats, only their members
+// may sign in, and memberships are exposed to clients as groups named after the chats.
+type BotChat struct {
+	BotId     int64
+	ChatId    int64
+	Group     string // Name of the group in the groups claim
+	CreatedAt time.Time
+}
+
+func NewBotChat(botId int64, chatId int64, group string) (*BotChat, error) {
+	if err := validateBotId(botId); err != nil {
+		return nil, err
+	}
+	if err := validateChatId(chatId); err != nil {
+		return nil, err
+	}
+	if err := validateGroupName(group); err != nil {
+		return nil, err
+	}
+	return &BotChat{
+		BotId:     botId,
+		ChatId:    chatId,
+		Group:     group,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,85 @@
+package entity
+
+import "time"
+
+// ClaimSource defines which bot user field a mapped claim is taken from.
+type ClaimSource string
+
+const (
+	ClaimSourceTelegramId   ClaimSource = "telegram_id"
+	ClaimSourceFirstName    ClaimSource = "first_name"
+	ClaimSourceLastName     ClaimSource = "last_name"
+	ClaimSourceFullName     ClaimSource = "full_name"
+	ClaimSourceUsername     ClaimSource = "username"
+	ClaimSourcePhotoUrl     ClaimSource = "photo_url"
+	ClaimSourceLanguageCode ClaimSource = "language_code"
+	ClaimSourceIsPremium    ClaimSource = "is_premium"
+)
+
+// BotClaimMapping emits a custom id_token claim when the scope is granted to a client of the bot.
+type BotClaimMapping struct {
+	BotId     int64
+	Scope     string
+	Claim     string
+	Source    ClaimSource
+	CreatedAt time.Time
+}
+
+func NewBotClaimMapping(botId int64, scope string, claim string, source ClaimSource) (*BotClaimMapping, error) {
+	if err := validateBotId(botId); err != nil {
+		return nil, err
+	}
+	if err := validateScope(scope); err != nil {
+		return nil, err
+	}
+	if err := validateClaimName(claim); err != nil {
+		return nil, err
+	}
+	if err := validateClaimSource(source); err != nil {
+		return nil, err
+	}
+	return &BotClaimMapping{
+		BotId:     botId,
+		Scope:     scope,
+		Claim:     claim,
+		Source:    source,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Resolve returns the claim value for the bot user, or nil when the source field is not set.
+func (m *BotClaimMapping) Resolve(botUser *BotUser) any {
+	user := botUser.User
+	switch m.Source {
+	case ClaimSourceTelegramId:
+		return botUser.UserId
+	case ClaimSourceFirstName:
+		return user.FirstName
+	case ClaimSourceLastName:
+		if user.LastName != nil {
+			return *user.LastName
+		}
+	case ClaimSourceFullName:
+		if user.LastName != nil {
+			return user.FirstName + " " + *user.LastName
+		}
+		return user.FirstName
+	case ClaimSourceUsername:
+		if user.Username != nil {
+			return *user.Username
+		}
+	case ClaimSourcePhotoUrl:
+		if user.PhotoUrl != nil {
+			return user.PhotoUrl.String()
+		}
+	case ClaimSourceLanguageCode:
+		if botUser.Language != nil {
+			return *botUser.Language
+		}
+	case ClaimSourceIsPremium:
+		if user.IsPremium != nil {
+			return *user.IsPremium
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// BotPhoto is the profile photo of a bot, stored by the provider since Telegram file
+// download links contain the bot token and cannot be shown to users.
+type BotPhoto struct {
+	BotId        int64
+	FileUniqueId string // Stays the same for the same photo, so it is used as the ETag
+	Content      []byte
+	ContentType  string
+	CreatedAt    time.Time
+}
+
+func NewBotPhoto(botId int64, fileUniqueId string, content []byte, contentType string) (*BotPhoto, error) {
+	if err := validateBotId(botId); err != nil {
+		return nil, err
+	}
+	if err := validateFileUniqueId(fileUniqueId); err != nil {
+		return nil, err
+	}
+	if err := validateBotPhotoContent(content, contentType); err != nil {
+		return nil, err
+	}
+	return &BotPhoto{
+		BotId:        botId,
+		FileUniqueId: fileUniqueId,
+		Content:      content,
+		ContentType:  contentType,
+		CreatedAt:    time.Now(),
+	}, nil
+}
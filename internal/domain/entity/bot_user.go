@@ -14,6 +14,7 @@ type BotUser struct {
 	UserAgent   *string
 	Language    *string
 	LastLoginAt time.Time
+	Version     int64 // Incremented on every update, used for optimistic concurrency control
 
 	CreatedAt time.Time
 	UpdatedAt *time.Time
@@ -43,6 +44,7 @@ func NewBotUser(botId, userId int64, user *User, ip netip.Addr, userAgent *strin
 		IP:          ip,
 		UserAgent:   userAgent,
 		Language:    language,
+		Version:     1,
 		CreatedAt:   time.Now(),
 		LastLoginAt: time.Now(),
 	}, nil
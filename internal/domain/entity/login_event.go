@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+)
+
+// LoginMethod defines how a login was attempted.
+type LoginMethod string
+
+const (
+	// LoginMethodWidget is a login with the Telegram login widget.
+	LoginMethodWidget LoginMethod = "widget"
+	// LoginMethodMiniApp is a login with the init data of the Telegram Mini App.
+	LoginMethodMiniApp LoginMethod = "mini_app"
+	// LoginMethodBot is a login confirmed in the bot through a deep link.
+	LoginMethodBot LoginMethod = "bot"
+	// LoginMethodLoginUrl is a Telegram login URL button that issues a login hint.
+	LoginMethodLoginUrl LoginMethod = "login_url"
+	// LoginMethodSkip is a login skipped by Hydra because the user has an authenticated session.
+	LoginMethodSkip LoginMethod = "skip"
+	// LoginMethodLoginHint is a login with a login hint issued by a Telegram login URL.
+	LoginMethodLoginHint LoginMethod = "login_hint"
+	// LoginMethodChallenge is a login challenge rejected before the user has chosen a login method.
+	LoginMethodChallenge LoginMethod = "challenge"
+)
+
+// LoginOutcome defines how a login attempt ended.
+type LoginOutcome string
+
+const (
+	// LoginOutcomeSuccess means that the login request was accepted in Hydra.
+	LoginOutcomeSuccess LoginOutcome = "success"
+	// LoginOutcomeIssued means that a login URL issued a login hint. The login itself is recorded
+	// when the hint is used.
+	LoginOutcomeIssued LoginOutcome = "issued"
+	// LoginOutcomeRejected means that the login request was rejected in Hydra,
+	// or for a login URL that the user was shown an error page.
+	LoginOutcomeRejected LoginOutcome = "rejected"
+	// LoginOutcomeFailed means that the attempt failed, but the user could continue with the login page.
+	LoginOutcomeFailed LoginOutcome = "failed"
+)
+
+// LoginEvent records a single login attempt for auditing.
+type LoginEvent struct {
+	Id        int64 // Assigned on creation
+	Method    LoginMethod
+	Outcome   LoginOutcome
+	ClientId  *string
+	BotId     *int64
+	UserId    *int64
+	ErrorCode *string // OAuth2 error the login was rejected with
+	IP        *netip.Addr
+	UserAgent *string
+	CreatedAt time.Time
+}
+
+func NewLoginEvent(
+	method LoginMethod,
+	outcome LoginOutcome,
+	clientId *string,
+	botId *int64,
+	userId *int64,
+	errorCode *string,
+	ip *netip.Addr,
+	userAgent *string,
+) (*LoginEvent, error) {
+	if err := validateLoginMethod(method); err != nil {
+		return nil, err
+	}
+	if err := validateLoginOutcome(outcome); err != nil {
+		return nil, err
+	}
+	if botId != nil {
+		if err := validateBotId(*botId); err != nil {
+			return nil, err
+		}
+	}
+	if userId != nil {
+		if err := validateUserId(*userId); err != nil {
+			return nil, err
+		}
+	}
+	if (outcome == LoginOutcomeSuccess || outcome == LoginOutcomeIssued) && userId == nil {
+		return nil, fmt.Errorf("successful login event must have a user id: %w", ErrInvariantCheckFailed)
+	}
+	if outcome != LoginOutcomeSuccess && outcome != LoginOutcomeIssued && (errorCode == nil || *errorCode == "") {
+		return nil, fmt.Errorf("unsuccessful login event must have an error code: %w", ErrInvariantCheckFailed)
+	}
+	if ip != nil {
+		if err := validateIP(*ip); err != nil {
+			return nil, err
+		}
+	}
+
+	return &LoginEvent{
+		Method:    method,
+		Outcome:   outcome,
+		ClientId:  clientId,
+		BotId:     botId,
+		UserId:    userId,
+		ErrorCode: errorCode,
+		IP:        ip,
+		UserAgent: userAgent,
+		CreatedAt: time.Now(),
+	}, nil
+}
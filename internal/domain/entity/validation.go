@@ -7,10 +7,26 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 var ErrInvariantCheckFailed = errors.New("invariant check failed")
 
+// maxBotStatusReasonLength matches the bots.status_reason column.
+const maxBotStatusReasonLength = 512
+
+// Telegram limits of bot descriptions, matching the bots.description and bots.short_description columns.
+const (
+	maxBotDescriptionLength      = 512
+	maxBotShortDescriptionLength = 120
+)
+
+// maxFileUniqueIdLength matches the bots.photo_file_unique_id and bot_photos.file_unique_id columns.
+const maxFileUniqueIdLength = 64
+
+// maxBotPhotoSize matches the photo size limit of the Telegram bot profile fetcher.
+const maxBotPhotoSize = 512 * 1024
+
 func validateUsername(username string) error {
 	if username == "" {
 		return fmt.Errorf("username cannot be empty: %w", ErrInvariantCheckFailed)
@@ -79,6 +95,145 @@ func validateBotUsername(username string) error {
 	return nil
 }
 
+func validateBotInitDataVerification(verification BotInitDataVerification) error {
+	switch verification {
+	case BotInitDataVerificationHash, BotInitDataVerificationSignature:
+		return nil
+	default:
+		return fmt.Errorf("unknown init data verification method: %w", ErrInvariantCheckFailed)
+	}
+}
+
+func validateBotStatus(status BotStatus) error {
+	switch status {
+	case BotStatusActive, BotStatusDisabled, BotStatusMaintenance:
+		return nil
+	default:
+		return fmt.Errorf("unknown bot status: %w", ErrInvariantCheckFailed)
+	}
+}
+
+func validateBotStatusReason(reason string) error {
+	if reason == "" {
+		return fmt.Errorf("bot status reason cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if strings.TrimSpace(reason) != reason {
+		return fmt.Errorf("bot status reason contains leading or trailing whitespace: %w", ErrInvariantCheckFailed)
+	}
+	if utf8.RuneCountInString(reason) > maxBotStatusReasonLength {
+		return fmt.Errorf("bot status reason is too long: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateBotDescription(description string, maxLength int) error {
+	if utf8.RuneCountInString(description) > maxLength {
+		return fmt.Errorf("bot description is too long: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateFileUniqueId(fileUniqueId string) error {
+	if fileUniqueId == "" {
+		return fmt.Errorf("file unique id cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(fileUniqueId) > maxFileUniqueIdLength {
+		return fmt.Errorf("file unique id is too long: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateBotPhotoContent(content []byte, contentType string) error {
+	if len(content) == 0 {
+		return fmt.Errorf("bot photo cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(content) > maxBotPhotoSize {
+		return fmt.Errorf("bot photo is too large: %w", ErrInvariantCheckFailed)
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return fmt.Errorf("bot photo is not an image: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateBotCredentialsStatus(status BotCredentialsStatus) error {
+	switch status {
+	case BotCredentialsStatusValid, BotCredentialsStatusInvalid:
+		return nil
+	default:
+		return fmt.Errorf("unknown credentials status: %w", ErrInvariantCheckFailed)
+	}
+}
+
+// reservedClaims are set by the OpenID provider and cannot be overridden by claim mappings.
+var reservedClaims = map[string]struct{}{
+	"iss": {}, "sub": {}, "aud": {}, "exp": {}, "iat": {}, "nbf": {}, "jti": {},
+	"auth_time": {}, "nonce": {}, "acr": {}, "amr": {}, "azp": {}, "at_hash": {}, "c_hash": {}, "sid": {},
+	"groups": {},
+}
+
+func validateScope(scope string) error {
+	if scope == "" {
+		return fmt.Errorf("scope cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(scope) > 64 {
+		return fmt.Errorf("scope is too long: %w", ErrInvariantCheckFailed)
+	}
+	for _, r := range scope {
+		if r <= ' ' || r == '"' || r == '\\' || r > '~' {
+			return fmt.Errorf("scope contains invalid characters: %w", ErrInvariantCheckFailed)
+		}
+	}
+	return nil
+}
+
+func validateClaimName(claim string) error {
+	if claim == "" {
+		return fmt.Errorf("claim name cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(claim) > 64 {
+		return fmt.Errorf("claim name is too long: %w", ErrInvariantCheckFailed)
+	}
+	for _, r := range claim {
+		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' || r == '.' || r == ':') {
+			return fmt.Errorf("claim name contains invalid characters: %w", ErrInvariantCheckFailed)
+		}
+	}
+	if _, ok := reservedClaims[claim]; ok {
+		return fmt.Errorf("claim name is reserved: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateClaimSource(source ClaimSource) error {
+	switch source {
+	case ClaimSourceTelegramId, ClaimSourceFirstName, ClaimSourceLastName, ClaimSourceFullName,
+		ClaimSourceUsername, ClaimSourcePhotoUrl, ClaimSourceLanguageCode, ClaimSourceIsPremium:
+		return nil
+	default:
+		return fmt.Errorf("unknown claim source: %w", ErrInvariantCheckFailed)
+	}
+}
+
+func validateLoginMethod(method LoginMethod) error {
+	switch method {
+	case LoginMethodWidget, LoginMethodMiniApp, LoginMethodBot, LoginMethodLoginUrl,
+		LoginMethodSkip, LoginMethodLoginHint, LoginMethodChallenge:
+		return nil
+	default:
+		return fmt.Errorf("unknown login method: %w", ErrInvariantCheckFailed)
+	}
+}
+
+func validateLoginOutcome(outcome LoginOutcome) error {
+	switch outcome {
+	case LoginOutcomeSuccess, LoginOutcomeIssued, LoginOutcomeRejected, LoginOutcomeFailed:
+		return nil
+	default:
+		return fmt.Errorf("unknown login outcome: %w", ErrInvariantCheckFailed)
+	}
+}
+
 func validateClientId(clientId string) error {
 	if clientId == "" {
 		return fmt.Errorf("client id cannot be empty: %w", ErrInvariantCheckFailed)
@@ -123,6 +278,21 @@ func validateUserUsername(username string) error {
 	return nil
 }
 
+func validateLanguageCode(language string) error {
+	if language == "" {
+		return fmt.Errorf("language code cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(language) > 10 {
+		return fmt.Errorf("language code is too long: %w", ErrInvariantCheckFailed)
+	}
+	for i, r := range language {
+		if !((r >= 'a' && r <= 'z') || (i > 0 && ((r >= '0' && r <= '9') || r == '-'))) {
+			return fmt.Errorf("language code contains invalid characters: %w", ErrInvariantCheckFailed)
+		}
+	}
+	return nil
+}
+
 func validateIP(ip netip.Addr) error {
 	if !ip.IsValid() || ip.IsUnspecified() {
 		return fmt.Errorf("invalid IP address: %w", ErrInvariantCheckFailed)
@@ -142,3 +312,26 @@ func validateUrl(url *url.URL) error {
 	}
 	return nil
 }
+
+func validateChatId(chatId int64) error {
+	if chatId == 0 {
+		return fmt.Errorf("chat id cannot be zero: %w", ErrInvariantCheckFailed)
+	}
+	return nil
+}
+
+func validateGroupName(group string) error {
+	if group == "" {
+		return fmt.Errorf("group name cannot be empty: %w", ErrInvariantCheckFailed)
+	}
+	if len(group) > 64 {
+		return fmt.Errorf("group name is too long: %w", ErrInvariantCheckFailed)
+	}
+	// ":" is reserved as the separator of the chat role in the groups claim
+	for _, r := range group {
+		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' || r == '.') {
+			return fmt.Errorf("group name contains invalid characters: %w", ErrInvariantCheckFailed)
+		}
+	}
+	return nil
+}
@@ -13,6 +13,9 @@ var (
 	// ErrDuplicate is returned when trying to create/update an entity with a duplicate unique field
 	ErrDuplicate = errors.New("duplicate entity")
 
+	// ErrConcurrentModification is returned when an entity was modified since it was read
+	ErrConcurrentModification = errors.New("concurrent modification")
+
 	// ErrCorruptedData is returned when data retrieved from database is corrupted or invalid
 	ErrCorruptedData = errors.New("corrupted data in database")
 
@@ -2,10 +2,43 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
 )
 
+// BotListFilter narrows down and paginates the bots returned by BotRepositoryPort.List.
+type BotListFilter struct {
+	// Linked selects only bots linked (true) or not linked (false) to a client. Nil selects both.
+	Linked *bool
+	// UsernamePrefix selects bots whose username starts with the prefix, case-insensitively.
+	UsernamePrefix *string
+	// AfterId selects bots with an ID greater than the given one (keyset pagination).
+	AfterId *int64
+	// Limit is the maximum number of bots returned.
+	Limit int
+}
+
+// BotUserListKey is the keyset position of a bot user in the list ordered by last login.
+type BotUserListKey struct {
+	LastLoginAt time.Time
+	UserId      int64
+}
+
+// BotUserListFilter narrows down and paginates the bot users returned by BotUserRepositoryPort.List.
+type BotUserListFilter struct {
+	// BotId selects users of the bot.
+	BotId int64
+	// Search selects users whose username, first name or last name contains the value, case-insensitively.
+	Search *string
+	// Ascending orders users by last login from the oldest one. Users are ordered from the latest one otherwise.
+	Ascending bool
+	// After selects users following the given position in the chosen order (keyset pagination).
+	After *BotUserListKey
+	// Limit is the maximum number of users returned.
+	Limit int
+}
+
 // BotRepositoryPort defines the interface for bot data access
 type BotRepositoryPort interface {
 	// GetByID retrieves a bot by its ID and populates the provided bot pointer.
@@ -18,13 +51,22 @@ type BotRepositoryPort interface {
 	Create(ctx context.Context, bot *entity.Bot) error
 
 	// Update updates an existing bot and refreshes the provided pointer.
+	// The credentials status is not stored, use UpdateCredentialsStatus.
+	// Returns ErrConcurrentModification if the bot version is stale.
 	Update(ctx context.Context, bot *entity.Bot) error
 
+	// UpdateCredentialsStatus stores the credentials status of a bot.
+	// Returns ErrConcurrentModification if the bot was modified since it was read.
+	UpdateCredentialsStatus(ctx context.Context, bot *entity.Bot) error
+
 	// Delete removes a bot by ID.
 	Delete(ctx context.Context, id int64) error
 
 	// ExistsByID checks if a bot exists by its ID.
 	ExistsByID(ctx context.Context, id int64) (bool, error)
+
+	// List retrieves bots matching the filter ordered by ID.
+	List(ctx context.Context, filter *BotListFilter) ([]*entity.Bot, error)
 }
 
 // BotUserRepositoryPort defines the interface for bot_user data access
@@ -35,12 +77,102 @@ type BotUserRepositoryPort interface {
 	// GetByBot retrieves all users for a specific bot.
 	GetByBot(ctx context.Context, botID int64) ([]*entity.BotUser, error)
 
+	// GetByUser retrieves the user across all bots.
+	GetByUser(ctx context.Context, userID int64) ([]*entity.BotUser, error)
+
+	// List retrieves bot users matching the filter ordered by last login and user ID.
+	List(ctx context.Context, filter *BotUserListFilter) ([]*entity.BotUser, error)
+
 	// Create stores a new bot user and populates the pointer with inserted data.
 	Create(ctx context.Context, botUser *entity.BotUser) error
 
 	// Update updates an existing bot user and refreshes the provided pointer.
+	// Returns ErrConcurrentModification if the bot user version is stale.
 	Update(ctx context.Context, botUser *entity.BotUser) error
 
 	// Delete removes a bot user.
 	Delete(ctx context.Context, botID, userID int64) error
+
+	// DeleteByUser removes the user across all bots and returns the number of removed rows.
+	DeleteByUser(ctx context.Context, userID int64) (int64, error)
+}
+
+// BotClaimMappingRepositoryPort defines the interface for bot_claim_mapping data access
+type BotClaimMappingRepositoryPort interface {
+	// GetByBot retrieves all claim mappings of a bot.
+	GetByBot(ctx context.Context, botID int64) ([]*entity.BotClaimMapping, error)
+
+	// ReplaceByBot replaces all claim mappings of a bot with the given ones.
+	ReplaceByBot(ctx context.Context, botID int64, mappings []*entity.BotClaimMapping) error
+}
+
+// BotChatRepositoryPort defines the interface for bot_chat data access
+type BotChatRepositoryPort interface {
+	// GetByBot retrieves all chats bound to a bot.
+	GetByBot(ctx context.Context, botID int64) ([]*entity.BotChat, error)
+
+	// ReplaceByBot replaces all chats of a bot with the given ones.
+	ReplaceByBot(ctx context.Context, botID int64, chats []*entity.BotChat) error
+}
+
+// BotPhotoRepositoryPort defines the interface for bot_photo data access
+type BotPhotoRepositoryPort interface {
+	// GetByBot retrieves the profile photo of a bot and populates the provided pointer.
+	GetByBot(ctx context.Context, botID int64, photo *entity.BotPhoto) error
+
+	// Save creates or replaces the profile photo of a bot.
+	Save(ctx context.Context, photo *entity.BotPhoto) error
+
+	// DeleteByBot removes the profile photo of a bot, if any.
+	DeleteByBot(ctx context.Context, botID int64) error
+}
+
+// BotAccessPolicyRepositoryPort defines the interface for bot_access_policy data access
+type BotAccessPolicyRepositoryPort interface {
+	// GetByBot retrieves the access policy of a bot and populates the provided pointer.
+	GetByBot(ctx context.Context, botID int64, policy *entity.BotAccessPolicy) error
+
+	// Save creates or replaces the access policy of a bot and refreshes the provided pointer.
+	Save(ctx context.Context, policy *entity.BotAccessPolicy) error
+
+	// DeleteByBot removes the access policy of a bot.
+	DeleteByBot(ctx context.Context, botID int64) error
+}
+
+// LoginEventListFilter narrows down and paginates the login events returned by LoginEventRepositoryPort.List.
+type LoginEventListFilter struct {
+	// BotId selects events of the bot.
+	BotId *int64
+	// UserId selects events of the Telegram user.
+	UserId *int64
+	// ClientId selects events of the OAuth2 client.
+	ClientId *string
+	// Outcome selects events with the outcome.
+	Outcome *entity.LoginOutcome
+	// Since selects events created at or after the time.
+	Since *time.Time
+	// Until selects events created before the time.
+	Until *time.Time
+	// BeforeId selects events with an ID less than the given one (keyset pagination).
+	BeforeId *int64
+	// Limit is the maximum number of events returned.
+	Limit int
+}
+
+// LoginEventRepositoryPort defines the interface for login_event data access
+type LoginEventRepositoryPort interface {
+	// Create stores a new login event and populates the pointer with inserted data.
+	Create(ctx context.Context, event *entity.LoginEvent) error
+
+	// GetByUser retrieves all events of the Telegram user ordered by ID.
+	GetByUser(ctx context.Context, userID int64) ([]*entity.LoginEvent, error)
+
+	// List retrieves login events matching the filter from the latest one.
+	List(ctx context.Context, filter *LoginEventListFilter) ([]*entity.LoginEvent, error)
+
+	// DeleteByUser removes all events of the Telegram user and returns the number of removed rows.
+	DeleteByUser(ctx context.Context, userID int64) (int64, error)
+
+	// DeleteBefore removes up to limit events created before the given time and returns the number of removed rows.
+	DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error)
 }
@@ -0,0 +1,77 @@
+package adminauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+const bearerPrefix = "Bearer "
+
+// APIKey is a static private API key stored as a SHA-256 hash.
+type APIKey struct {
+	Id     string
+	Hash   string // Hex-encoded SHA-256 of the key
+	Scopes []string
+}
+
+type apiKeyEntry struct {
+	id     string
+	hash   []byte
+	scopes []string
+}
+
+// APIKeyAuthenticator authenticates requests with "Authorization: Bearer <key>".
+type APIKeyAuthenticator struct {
+	keys []apiKeyEntry
+}
+
+var _ service.AdminAuthenticator = (*APIKeyAuthenticator)(nil)
+
+func NewAPIKeyAuthenticator(keys []APIKey) (*APIKeyAuthenticator, error) {
+	entries := make([]apiKeyEntry, 0, len(keys))
+	for _, key := range keys {
+		hash, err := hex.DecodeString(key.Hash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %q has invalid hash", key.Id)
+		}
+		entries = append(entries, apiKeyEntry{id: key.Id, hash: hash, scopes: key.Scopes})
+	}
+	return &APIKeyAuthenticator{keys: entries}, nil
+}
+
+func (a *APIKeyAuthenticator) Authenticate(_ context.Context, request *service.AdminAuthRequest) (*service.AdminPrincipal, error) {
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	authorization := request.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, service.ErrAdminCredentialsMissing
+	}
+	key := strings.TrimSpace(strings.TrimPrefix(authorization, bearerPrefix))
+	if key == "" {
+		return nil, service.ErrAdminCredentialsMissing
+	}
+
+	hash := sha256.Sum256([]byte(key))
+
+	// Compare against every key so that timing does not reveal which key matched
+	var matched *apiKeyEntry
+	for i := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], a.keys[i].hash) == 1 {
+			matched = &a.keys[i]
+		}
+	}
+	if matched == nil {
+		return nil, service.ErrAdminCredentialsInvalid
+	}
+
+	return &service.AdminPrincipal{KeyId: matched.id, Scopes: matched.scopes}, nil
+}
@@ -0,0 +1,159 @@
+package adminauth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+const (
+	KeyIdHeader     = "X-Admin-Key-Id"
+	TimestampHeader = "X-Admin-Timestamp"
+	NonceHeader     = "X-Admin-Nonce"
+	SignatureHeader = "X-Admin-Signature"
+)
+
+// maxNonceLength limits the nonce stored for every signed request.
+const maxNonceLength = 128
+
+// NonceGuard remembers the nonces of signed requests, so that a captured request cannot be replayed.
+type NonceGuard interface {
+	// CheckAndMarkUsed returns service.ErrReplayDetected when the nonce was already used within ttl.
+	CheckAndMarkUsed(ctx context.Context, nonce string, ttl time.Duration) error
+}
+
+// HMACKey is a shared secret used to sign private API requests.
+type HMACKey struct {
+	Id     string
+	Secret string
+	Scopes []string
+}
+
+// HMACAuthenticator authenticates requests signed with a shared secret.
+// The signature is hex(HMAC-SHA256(secret, method + "\n" + request_uri + "\n" + timestamp + "\n" + nonce + "\n" + hex(SHA-256(body))))
+// where timestamp is the X-Admin-Timestamp header in Unix seconds and nonce is the X-Admin-Nonce header,
+// unique per request of a key.
+type HMACAuthenticator struct {
+	keys       map[string]HMACKey
+	maxSkew    time.Duration
+	nonceGuard NonceGuard
+}
+
+var _ service.AdminAuthenticator = (*HMACAuthenticator)(nil)
+
+func NewHMACAuthenticator(keys []HMACKey, maxSkew time.Duration, nonceGuard NonceGuard) (*HMACAuthenticator, error) {
+	if maxSkew <= 0 {
+		return nil, errors.New("signature max skew must be positive")
+	}
+	if nonceGuard == nil {
+		return nil, errors.New("nonce guard cannot be nil")
+	}
+
+	keysById := make(map[string]HMACKey, len(keys))
+	for _, key := range keys {
+		if _, ok := keysById[key.Id]; ok {
+			return nil, errors.New("duplicate hmac key id: " + key.Id)
+		}
+		keysById[key.Id] = key
+	}
+	return &HMACAuthenticator{keys: keysById, maxSkew: maxSkew, nonceGuard: nonceGuard}, nil
+}
+
+// StringToSign builds the canonical request representation covered by the signature.
+func StringToSign(method, requestUri, timestamp, nonce string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	return strings.Join([]string{
+		strings.ToUpper(method),
+		requestUri,
+		timestamp,
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+}
+
+// verifyTimestamp checks the timestamp against the allowed skew and returns how long the request stays valid.
+func (a *HMACAuthenticator) verifyTimestamp(timestamp string) (time.Duration, error) {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return 0, service.ErrAdminCredentialsInvalid
+	}
+	skew := time.Since(time.Unix(unix, 0))
+	if skew > a.maxSkew || skew < -a.maxSkew {
+		return 0, service.ErrAdminCredentialsInvalid
+	}
+	return a.maxSkew - skew, nil
+}
+
+func validateNonce(nonce string) error {
+	if nonce == "" || len(nonce) > maxNonceLength {
+		return service.ErrAdminCredentialsInvalid
+	}
+	for _, r := range nonce {
+		if r <= ' ' || r > '~' {
+			return service.ErrAdminCredentialsInvalid
+		}
+	}
+	return nil
+}
+
+// markNonceUsed rejects a nonce used before. The nonce is remembered as long as the timestamp is accepted.
+func (a *HMACAuthenticator) markNonceUsed(ctx context.Context, keyId, nonce string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return service.ErrAdminCredentialsInvalid
+	}
+	if err := a.nonceGuard.CheckAndMarkUsed(ctx, keyId+":"+nonce, ttl); err != nil {
+		if errors.Is(err, service.ErrReplayDetected) {
+			return fmt.Errorf("%w: nonce was already used", service.ErrAdminCredentialsInvalid)
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *HMACAuthenticator) Authenticate(ctx context.Context, request *service.AdminAuthRequest) (*service.AdminPrincipal, error) {
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	keyId := request.Header.Get(KeyIdHeader)
+	timestamp := request.Header.Get(TimestampHeader)
+	nonce := request.Header.Get(NonceHeader)
+	signature := request.Header.Get(SignatureHeader)
+	if keyId == "" && timestamp == "" && nonce == "" && signature == "" {
+		return nil, service.ErrAdminCredentialsMissing
+	}
+
+	key, ok := a.keys[keyId]
+	if !ok {
+		return nil, service.ErrAdminCredentialsInvalid
+	}
+	validFor, err := a.verifyTimestamp(timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNonce(nonce); err != nil {
+		return nil, err
+	}
+
+	expected := hmac.New(sha256.New, []byte(key.Secret))
+	expected.Write([]byte(StringToSign(request.Method, request.RequestUri, timestamp, nonce, request.Body)))
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected.Sum(nil), actual) {
+		return nil, service.ErrAdminCredentialsInvalid
+	}
+
+	// Checked after the signature, so that unsigned requests cannot use up nonces
+	if err := a.markNonceUsed(ctx, key.Id, nonce, validFor); err != nil {
+		return nil, err
+	}
+
+	return &service.AdminPrincipal{KeyId: key.Id, Scopes: key.Scopes}, nil
+}
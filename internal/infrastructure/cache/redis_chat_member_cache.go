@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+type RedisChatMemberCache struct {
+	redis       *redis.Client
+	cachePrefix string
+	cacheExp    time.Duration
+}
+
+var _ service.TelegramChatMemberCache = (*RedisChatMemberCache)(nil)
+
+func NewRedisChatMemberCache(redisClient *redis.Client, cachePrefix string, cacheExp time.Duration) (*RedisChatMemberCache, error) {
+	if redisClient == nil {
+		return nil, errors.New("redis client cannot be nil")
+	}
+	return &RedisChatMemberCache{
+		redis:       redisClient,
+		cachePrefix: cachePrefix,
+		cacheExp:    cacheExp,
+	}, nil
+}
+
+// chatMemberRecord is the JSON representation of a chat membership stored in Redis.
+type chatMemberRecord struct {
+	Status   string `json:"status"`
+	IsMember bool   `json:"is_member"`
+}
+
+func (c *RedisChatMemberCache) getCacheKey(botId, chatId, userId int64) string {
+	return fmt.Sprintf("%s%d:%d:%d", c.cachePrefix, botId, chatId, userId)
+}
+
+func (c *RedisChatMemberCache) GetChatMember(ctx context.Context, botId, chatId, userId int64) (*service.TelegramChatMember, error) {
+	key := c.getCacheKey(botId, chatId, userId)
+	log := zerolog.Ctx(ctx).With().Str("service", "redisChatMemberCache").Str("cache_key", key).Logger()
+	val, err := c.redis.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		log.Debug().Msg("chat member not found in cache")
+		return nil, service.ErrChatMemberNotInCache
+	}
+	if err != nil {
+		log.Err(err).Msg("failed to get chat member from cache")
+		return nil, err
+	}
+
+	var record chatMemberRecord
+	if err := json.Unmarshal(val, &record); err != nil {
+		log.Err(err).Msg("failed to decode cached chat member")
+		return nil, service.ErrChatMemberNotInCache
+	}
+
+	log.Debug().Str("status", record.Status).Msg("chat member retrieved from cache")
+	return &service.TelegramChatMember{
+		Status:   service.TelegramChatMemberStatus(record.Status),
+		IsMember: record.IsMember,
+	}, nil
+}
+
+func (c *RedisChatMemberCache) CacheChatMember(ctx context.Context, botId, chatId, userId int64, member *service.TelegramChatMember) error {
+	if member == nil {
+		return errors.New("chat member cannot be nil")
+	}
+
+	key := c.getCacheKey(botId, chatId, userId)
+	val, err := json.Marshal(chatMemberRecord{Status: string(member.Status), IsMember: member.IsMember})
+	if err != nil {
+		return err
+	}
+
+	zerolog.Ctx(ctx).Debug().Str("service", "redisChatMemberCache").Str("cache_key", key).Msg("caching chat member")
+	return c.redis.Set(ctx, key, val, c.cacheExp).Err()
+}
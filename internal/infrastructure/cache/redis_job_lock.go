@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+type RedisJobLock struct {
+	redis  *redis.Client
+	prefix string
+}
+
+var _ service.JobLock = (*RedisJobLock)(nil)
+
+func NewRedisJobLock(redisClient *redis.Client, prefix string) (*RedisJobLock, error) {
+	if redisClient == nil {
+		return nil, errors.New("redis client cannot be nil")
+	}
+	return &RedisJobLock{
+		redis:  redisClient,
+		prefix: prefix,
+	}, nil
+}
+
+func (l *RedisJobLock) getKey(job string) string {
+	return l.prefix + job
+}
+
+func (l *RedisJobLock) TryLock(ctx context.Context, job string, ttl time.Duration) error {
+	key := l.getKey(job)
+	log := zerolog.Ctx(ctx).With().Str("service", "redisJobLock").Str("key", key).Logger()
+
+	set, err := l.redis.SetNX(ctx, key, "1", ttl).Result()
+	if err != nil {
+		log.Err(err).Msg("failed to take job lock")
+		return err
+	}
+	if !set {
+		return service.ErrJobLocked
+	}
+
+	return nil
+}
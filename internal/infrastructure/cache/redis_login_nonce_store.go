@@ -0,0 +1,288 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// loginNonceSize is the number of random bytes in a nonce; encoded it fits Telegram's 64 char start parameter limit.
+const loginNonceSize = 24
+
+// loginNonceConfirmAttempts limits the retries of a confirmation interrupted by a concurrent change of the nonce.
+const loginNonceConfirmAttempts = 3
+
+type RedisLoginNonceStore struct {
+	redis  *redis.Client
+	prefix string
+}
+
+var _ service.TelegramLoginNonceStore = (*RedisLoginNonceStore)(nil)
+
+func NewRedisLoginNonceStore(redisClient *redis.Client, prefix string) (*RedisLoginNonceStore, error) {
+	if redisClient == nil {
+		return nil, errors.New("redis client cannot be nil")
+	}
+	return &RedisLoginNonceStore{
+		redis:  redisClient,
+		prefix: prefix,
+	}, nil
+}
+
+// loginNonceRecord is the JSON representation of a nonce stored in Redis.
+type loginNonceRecord struct {
+	BotId          int64           `json:"bot_id"`
+	LoginChallenge string          `json:"login_challenge"`
+	User           *loginNonceUser `json:"user,omitempty"`
+	BindingHash    string          `json:"binding_hash,omitempty"` // SHA-256 of the browser binding of a login hint
+}
+
+type loginNonceUser struct {
+	Id           int64   `json:"id"`
+	FirstName    string  `json:"first_name"`
+	LastName     *string `json:"last_name,omitempty"`
+	Username     *string `json:"username,omitempty"`
+	LanguageCode *string `json:"language_code,omitempty"`
+	PhotoUrl     *string `json:"photo_url,omitempty"`
+	IsPremium    *bool   `json:"is_premium,omitempty"`
+}
+
+func (s *RedisLoginNonceStore) getKey(nonce string) string {
+	return s.prefix + nonce
+}
+
+// generateNonce returns a random URL-safe nonce.
+func generateNonce() (string, error) {
+	buf := make([]byte, loginNonceSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *RedisLoginNonceStore) store(ctx context.Context, nonce string, record *loginNonceRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	key := s.getKey(nonce)
+	log := zerolog.Ctx(ctx).With().Str("service", "redisLoginNonceStore").Str("key", key).Logger()
+	if err := s.redis.Set(ctx, key, data, ttl).Err(); err != nil {
+		log.Err(err).Msg("failed to store login nonce")
+		return err
+	}
+
+	return nil
+}
+
+func (s *RedisLoginNonceStore) Issue(ctx context.Context, botId int64, loginChallenge string, ttl time.Duration) (string, error) {
+	nonce, err := generateNonce()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.store(ctx, nonce, &loginNonceRecord{BotId: botId, LoginChallenge: loginChallenge}, ttl); err != nil {
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+func (s *RedisLoginNonceStore) IssueLoginHint(
+	ctx context.Context,
+	botId int64,
+	user *service.TelegramUserData,
+	ttl time.Duration,
+) (string, string, error) {
+	if user == nil {
+		return "", "", errors.New("user cannot be nil")
+	}
+
+	loginHint, err := generateNonce()
+	if err != nil {
+		return "", "", err
+	}
+	binding, err := generateNonce()
+	if err != nil {
+		return "", "", err
+	}
+
+	record := &loginNonceRecord{
+		BotId:       botId,
+		User:        toLoginNonceUser(user),
+		BindingHash: hashBinding(binding),
+	}
+	if err := s.store(ctx, loginHint, record, ttl); err != nil {
+		return "", "", err
+	}
+
+	return loginHint, binding, nil
+}
+
+func (s *RedisLoginNonceStore) load(ctx context.Context, key string) (*loginNonceRecord, error) {
+	return decodeLoginNonce(s.redis.Get(ctx, key).Bytes())
+}
+
+func decodeLoginNonce(raw []byte, err error) (*loginNonceRecord, error) {
+	if err == redis.Nil {
+		return nil, service.ErrLoginNonceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record loginNonceRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode login nonce: %w", err)
+	}
+	return &record, nil
+}
+
+func (s *RedisLoginNonceStore) Get(ctx context.Context, nonce string) (*service.TelegramLoginNonce, error) {
+	record, err := s.load(ctx, s.getKey(nonce))
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toNonce()
+}
+
+// ConsumeLoginHint removes the login hint with GETDEL, so concurrent requests cannot both use it.
+func (s *RedisLoginNonceStore) ConsumeLoginHint(ctx context.Context, loginHint string, binding string) (*service.TelegramLoginNonce, error) {
+	record, err := decodeLoginNonce(s.redis.GetDel(ctx, s.getKey(loginHint)).Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if record.BindingHash == "" ||
+		subtle.ConstantTimeCompare([]byte(record.BindingHash), []byte(hashBinding(binding))) != 1 {
+		return nil, service.ErrLoginHintBindingMismatch
+	}
+
+	return record.toNonce()
+}
+
+func (record *loginNonceRecord) toNonce() (*service.TelegramLoginNonce, error) {
+	output := &service.TelegramLoginNonce{
+		BotId:          record.BotId,
+		LoginChallenge: record.LoginChallenge,
+	}
+	if record.User != nil {
+		user := &service.TelegramUserData{
+			Id:           record.User.Id,
+			FirstName:    record.User.FirstName,
+			LastName:     record.User.LastName,
+			Username:     record.User.Username,
+			LanguageCode: record.User.LanguageCode,
+			IsPremium:    record.User.IsPremium,
+		}
+		if record.User.PhotoUrl != nil {
+			photoUrl, err := url.Parse(*record.User.PhotoUrl)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode login nonce photo url: %w", err)
+			}
+			user.PhotoUrl = photoUrl
+		}
+		output.User = user
+	}
+
+	return output, nil
+}
+
+// Confirm sets the user of a pending nonce under WATCH, so that concurrent confirmations cannot
+// overwrite each other and only the first one succeeds.
+func (s *RedisLoginNonceStore) Confirm(ctx context.Context, nonce string, user *service.TelegramUserData) error {
+	if user == nil {
+		return errors.New("user cannot be nil")
+	}
+
+	key := s.getKey(nonce)
+	log := zerolog.Ctx(ctx).With().Str("service", "redisLoginNonceStore").Str("key", key).Logger()
+
+	confirm := func(tx *redis.Tx) error {
+		record, err := decodeLoginNonce(tx.Get(ctx, key).Bytes())
+		if err != nil {
+			return err
+		}
+		if record.User != nil {
+			return service.ErrLoginNonceConfirmed
+		}
+
+		record.User = toLoginNonceUser(user)
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		// XX keeps the nonce from being resurrected if it expired in the meantime.
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.SetArgs(ctx, key, data, redis.SetArgs{Mode: "XX", KeepTTL: true})
+			return nil
+		})
+		return err
+	}
+
+	for range loginNonceConfirmAttempts {
+		err := s.redis.Watch(ctx, confirm, key)
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, redis.TxFailedErr):
+			// The nonce changed meanwhile, the next attempt sees whether it is still pending
+			continue
+		case err == redis.Nil:
+			return service.ErrLoginNonceNotFound
+		case errors.Is(err, service.ErrLoginNonceNotFound), errors.Is(err, service.ErrLoginNonceConfirmed):
+			return err
+		default:
+			log.Err(err).Msg("failed to confirm login nonce")
+			return err
+		}
+	}
+
+	return service.ErrLoginNonceConfirmed
+}
+
+func toLoginNonceUser(user *service.TelegramUserData) *loginNonceUser {
+	output := &loginNonceUser{
+		Id:           user.Id,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		Username:     user.Username,
+		LanguageCode: user.LanguageCode,
+		IsPremium:    user.IsPremium,
+	}
+	if user.PhotoUrl != nil {
+		output.PhotoUrl = utils.Ptr(user.PhotoUrl.String())
+	}
+	return output
+}
+
+// Consume removes the nonce with GETDEL, so concurrent requests cannot both use it.
+func (s *RedisLoginNonceStore) Consume(ctx context.Context, nonce string) (*service.TelegramLoginNonce, error) {
+	record, err := decodeLoginNonce(s.redis.GetDel(ctx, s.getKey(nonce)).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toNonce()
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// slidingWindowScript keeps the timestamps (in microseconds) of the requests within the window
+// in a sorted set and admits the request only while the set holds fewer entries than the limit.
+// Returns {allowed, remaining, retry after in microseconds}.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+if count >= limit then
+	local retryAfter = window
+	local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	if oldest[2] then
+		retryAfter = tonumber(oldest[2]) + window - now
+	end
+	return {0, 0, retryAfter}
+end
+
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], math.ceil(window / 1000))
+return {1, limit - count - 1, 0}
+`)
+
+// RedisRateLimiter implements a sliding window log rate limiter on top of Redis sorted sets.
+type RedisRateLimiter struct {
+	redis  *redis.Client
+	prefix string
+}
+
+var _ service.RateLimiter = (*RedisRateLimiter)(nil)
+
+func NewRedisRateLimiter(redisClient *redis.Client, prefix string) (*RedisRateLimiter, error) {
+	if redisClient == nil {
+		return nil, errors.New("redis client cannot be nil")
+	}
+	return &RedisRateLimiter{
+		redis:  redisClient,
+		prefix: prefix,
+	}, nil
+}
+
+func (l *RedisRateLimiter) getKey(key string) string {
+	return l.prefix + key
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, limit service.RateLimit) (*service.RateLimitResult, error) {
+	if limit.Limit <= 0 || limit.Window <= 0 {
+		return nil, errors.New("rate limit must be positive")
+	}
+
+	// Requests within the same microsecond must not collapse into one sorted set member
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate request id: %w", err)
+	}
+	now := time.Now().UnixMicro()
+	member := fmt.Sprintf("%d-%s", now, hex.EncodeToString(buf))
+
+	values, err := slidingWindowScript.Run(
+		ctx,
+		l.redis,
+		[]string{l.getKey(key)},
+		now,
+		limit.Window.Microseconds(),
+		limit.Limit,
+		member,
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("unexpected rate limiter response of %d values", len(values))
+	}
+
+	return &service.RateLimitResult{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+	}, nil
+}
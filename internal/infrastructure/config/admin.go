@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+// AdminAPIKeyConfig describes a static API key of the private API.
+type AdminAPIKeyConfig struct {
+	Id     string   `yaml:"id"     validate:"required"`
+	Hash   string   `yaml:"hash"   validate:"required,hexadecimal,len=64"` // Hex-encoded SHA-256 of the key
+	Scopes []string `yaml:"scopes" validate:"required,min=1"`
+}
+
+// AdminHMACKeyConfig describes a shared secret used to sign private API requests.
+type AdminHMACKeyConfig struct {
+	Id     string   `yaml:"id"     validate:"required"`
+	Secret string   `yaml:"secret" validate:"required,min=32"`
+	Scopes []string `yaml:"scopes" validate:"required,min=1"`
+}
+
+// AdminAuthConfig holds private API authentication settings.
+// Private operations are rejected when no keys are configured.
+// Nonces of signed requests are remembered in Redis under NoncePrefix until their timestamp expires.
+type AdminAuthConfig struct {
+	APIKeys          []AdminAPIKeyConfig  `yaml:"api_keys"           validate:"dive"`
+	HMACKeys         []AdminHMACKeyConfig `yaml:"hmac_keys"          validate:"dive"`
+	SignatureMaxSkew time.Duration        `yaml:"signature_max_skew" validate:"required,gt=0"`
+	NoncePrefix      string               `yaml:"nonce_prefix"       validate:"required"`
+}
@@ -1,10 +1,12 @@
 package config
 
 type Config struct {
-	HTTPServer HTTPServerConfig `yaml:"http_server" validate:"required"`
-	Database   DatabaseConfig   `yaml:"database"    validate:"required"`
-	Redis      RedisConfig      `yaml:"redis"       validate:"required"`
-	Security   SecurityConfig   `yaml:"security"    validate:"required"`
-	Hydra      HydraConfig      `yaml:"hydra"       validate:"required"`
-	Logger     LoggerConfig     `yaml:"logger"      validate:"required"`
+	HTTPServer  HTTPServerConfig   `yaml:"http_server"  validate:"required"`
+	AdminServer *AdminServerConfig `yaml:"admin_server"`
+	Database    DatabaseConfig     `yaml:"database"     validate:"required"`
+	Redis       RedisConfig        `yaml:"redis"        validate:"required"`
+	Security    SecurityConfig     `yaml:"security"     validate:"required"`
+	Hydra       HydraConfig        `yaml:"hydra"        validate:"required"`
+	Logger      LoggerConfig       `yaml:"logger"       validate:"required"`
+	Jobs        JobsConfig         `yaml:"jobs"`
 }
@@ -3,15 +3,33 @@ package config
 import "time"
 
 const (
-	defaultTelegramAuthURI              = "https://oauth.telegram.org/auth"
-	defaultTelegramAuthDataTTL          = 5 * time.Minute
-	defaultTelegramTokenVerificationTTL = 5 * time.Minute
-	defaultTelegramReplayGuardTTL       = 5 * time.Minute
+	defaultTelegramAuthURI               = "https://oauth.telegram.org/auth"
+	defaultTelegramDeepLinkURI           = "https://t.me"
+	defaultTelegramAuthDataTTL           = 5 * time.Minute
+	defaultTelegramTokenVerificationTTL  = 5 * time.Minute
+	defaultTelegramChatMemberCachePrefix = "chat_member:"
+	defaultTelegramChatMemberCacheTTL    = time.Minute
+	defaultTelegramReplayGuardTTL        = 5 * time.Minute
+	defaultTelegramBotLoginPrefix        = "bot_login:"
+	defaultTelegramBotLoginTTL           = 5 * time.Minute
+	defaultAdminSignatureMaxSkew         = 5 * time.Minute
+	defaultAdminNoncePrefix              = "admin_nonce:"
+	defaultRateLimitPrefix               = "rate_limit:"
+	defaultTrustedProxyHeader            = "x-forwarded-for"
+	defaultJobLockPrefix                 = "job_lock:"
+	defaultBotCredentialsJobInterval     = time.Hour
+	defaultLoginEventsJobInterval        = time.Hour
+	defaultLoginEventsRetention          = 90 * 24 * time.Hour
+	defaultTelegramMiniAppPublicKey      = "e7bf03a2fa4602af4580703d88dda5bb59f32ed8b02a56c187fe7d34caed242d" // Telegram production key
 )
 
 var defaultConfig = Config{
 	HTTPServer: HTTPServerConfig{
-		TelegramAuthURI: MustParseURL(defaultTelegramAuthURI),
+		TelegramAuthURI:     MustParseURL(defaultTelegramAuthURI),
+		TelegramDeepLinkURI: MustParseURL(defaultTelegramDeepLinkURI),
+		TrustedProxies: TrustedProxiesConfig{
+			Header: defaultTrustedProxyHeader,
+		},
 	},
 	Database: DatabaseConfig{
 		DSN: MustParseURL("postgres://localhost:5432/dbname?sslmode=disable"),
@@ -26,9 +44,37 @@ var defaultConfig = Config{
 			TokenVerificationCache: TelegramTokenVerificationCacheConfig{
 				TTL: defaultTelegramTokenVerificationTTL,
 			},
+			ChatMemberCache: TelegramChatMemberCacheConfig{
+				Prefix: defaultTelegramChatMemberCachePrefix,
+				TTL:    defaultTelegramChatMemberCacheTTL,
+			},
 			ReplayGuard: TelegramReplayGuardConfig{
 				TTL: defaultTelegramReplayGuardTTL,
 			},
+			MiniAppSignature: TelegramMiniAppSignatureConfig{
+				PublicKey: defaultTelegramMiniAppPublicKey,
+			},
+			BotLogin: TelegramBotLoginConfig{
+				Prefix: defaultTelegramBotLoginPrefix,
+				TTL:    defaultTelegramBotLoginTTL,
+			},
+		},
+		Admin: AdminAuthConfig{
+			SignatureMaxSkew: defaultAdminSignatureMaxSkew,
+			NoncePrefix:      defaultAdminNoncePrefix,
+		},
+		RateLimit: RateLimitConfig{
+			Prefix: defaultRateLimitPrefix,
+		},
+	},
+	Jobs: JobsConfig{
+		LockPrefix: defaultJobLockPrefix,
+		BotCredentials: BotCredentialsJobConfig{
+			Interval: defaultBotCredentialsJobInterval,
+		},
+		LoginEvents: LoginEventsJobConfig{
+			Interval:  defaultLoginEventsJobInterval,
+			Retention: defaultLoginEventsRetention,
 		},
 	},
 }
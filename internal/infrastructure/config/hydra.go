@@ -2,5 +2,6 @@ package config
 
 // HydraConfig represents Hydra OAuth2/OIDC server configuration.
 type HydraConfig struct {
-	AdminURL *URL `yaml:"admin_url" validate:"required"` // Hydra Admin API URL
+	AdminURL  *URL `yaml:"admin_url"  validate:"required"` // Hydra Admin API URL
+	PublicURL *URL `yaml:"public_url"`                     // Hydra issuer URL, sent as "iss" in third-party initiated logins
 }
@@ -0,0 +1,22 @@
+package config
+
+import "time"
+
+// JobsConfig holds background job settings.
+// Runs are locked in Redis under LockPrefix, so that a job runs on a single instance per interval.
+type JobsConfig struct {
+	LockPrefix     string                  `yaml:"lock_prefix"     validate:"required"`
+	BotCredentials BotCredentialsJobConfig `yaml:"bot_credentials"`
+	LoginEvents    LoginEventsJobConfig    `yaml:"login_events"`
+}
+
+// BotCredentialsJobConfig holds settings of the job that re-verifies bot tokens with Telegram.
+type BotCredentialsJobConfig struct {
+	Interval time.Duration `yaml:"interval" validate:"gte=0"` // Zero disables the job
+}
+
+// LoginEventsJobConfig holds settings of the job that removes login events older than Retention.
+type LoginEventsJobConfig struct {
+	Interval  time.Duration `yaml:"interval"  validate:"gte=0"` // Zero disables the job
+	Retention time.Duration `yaml:"retention" validate:"required,gt=0"`
+}
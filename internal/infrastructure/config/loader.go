@@ -8,7 +8,13 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-var configValidator = validator.New()
+var configValidator = newConfigValidator()
+
+func newConfigValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterStructValidation(validateTelegramBotLoginConfig, TelegramBotLoginConfig{})
+	return v
+}
 
 func Read(path string) (*Config, error) {
 	data, err := os.ReadFile(path)
@@ -0,0 +1,24 @@
+package config
+
+import "time"
+
+// RateLimitRuleConfig allows up to Limit requests within a sliding Window.
+type RateLimitRuleConfig struct {
+	Limit  int           `yaml:"limit"  validate:"required,gt=0"`
+	Window time.Duration `yaml:"window" validate:"required,gt=0"`
+}
+
+// RateLimitRouteConfig holds the limits of a route. Limits left unset are not enforced.
+type RateLimitRouteConfig struct {
+	IP   *RateLimitRuleConfig `yaml:"ip"`   // Per client IP address
+	Bot  *RateLimitRuleConfig `yaml:"bot"`  // Per Telegram bot
+	User *RateLimitRuleConfig `yaml:"user"` // Per Telegram user, applies to login routes only
+}
+
+// RateLimitConfig holds Redis-backed rate limits of the login callbacks and bot sync.
+type RateLimitConfig struct {
+	Prefix          string               `yaml:"prefix"           validate:"required"`
+	WidgetCallback  RateLimitRouteConfig `yaml:"widget_callback"`
+	MiniAppCallback RateLimitRouteConfig `yaml:"miniapp_callback"`
+	CreateBot       RateLimitRouteConfig `yaml:"create_bot"`
+}
@@ -1,12 +1,42 @@
 package config
 
+// BotTokenKeySourceConfig describes where a bot token encryption key is loaded from.
+// Source selects the location:
+//   - inline (default): Key holds the key
+//   - file: the key is read from Path, e.g. a mounted Kubernetes secret
+//   - env: the key is read from the Env environment variable
+//   - envelope: WrappedKey holds a data key wrapped by the master key read from MasterKeyFile
+type BotTokenKeySourceConfig struct {
+	Source        string `yaml:"source"          validate:"omitempty,oneof=inline file env envelope"`
+	Key           string `yaml:"key"             validate:"required_if=Source inline"`
+	Path          string `yaml:"path"            validate:"required_if=Source file"`
+	Env           string `yaml:"env"             validate:"required_if=Source env"`
+	WrappedKey    string `yaml:"wrapped_key"     validate:"required_if=Source envelope,omitempty,base64"`
+	MasterKeyFile string `yaml:"master_key_file" validate:"required_if=Source envelope"`
+}
+
+// BotTokenKeyConfig represents a bot token encryption key of the keyring.
+type BotTokenKeyConfig struct {
+	Id                      string `yaml:"id" validate:"required"`
+	BotTokenKeySourceConfig `yaml:",inline"`
+}
+
 // SecurityBotTokenConfig represents bot token security settings.
+// Tokens are encrypted with the active key and decrypted with any configured key.
+// The legacy key is the key used before keyrings were introduced: it decrypts tokens without
+// a key id and encrypts new ones only when no keys are configured. It is set either inline with
+// EncryptionKey or with LegacyKey, which accepts the same sources as the keyring keys.
 type SecurityBotTokenConfig struct {
-	EncryptionKey string `yaml:"encryption_key" validate:"required"`
+	EncryptionKey string                   `yaml:"encryption_key" validate:"required_without_all=Keys LegacyKey,excluded_with=LegacyKey"`
+	LegacyKey     *BotTokenKeySourceConfig `yaml:"legacy_key"`
+	ActiveKeyId   string                   `yaml:"active_key_id"  validate:"required_with=Keys"`
+	Keys          []BotTokenKeyConfig      `yaml:"keys"           validate:"dive"`
 }
 
 // SecurityConfig represents application security configuration.
 type SecurityConfig struct {
-	BotToken SecurityBotTokenConfig `yaml:"bot_token" validate:"required"`
-	Telegram TelegramSecurityConfig `yaml:"telegram"  validate:"required"`
+	BotToken  SecurityBotTokenConfig `yaml:"bot_token" validate:"required"`
+	Telegram  TelegramSecurityConfig `yaml:"telegram"  validate:"required"`
+	Admin     AdminAuthConfig        `yaml:"admin"     validate:"required"`
+	RateLimit RateLimitConfig        `yaml:"rate_limit" validate:"required"`
 }
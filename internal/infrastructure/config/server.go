@@ -2,7 +2,31 @@ package config
 
 // HTTPServerConfig represents HTTP server configuration.
 type HTTPServerConfig struct {
-	Address         string `yaml:"address"           validate:"required"`
-	BaseUri         URL    `yaml:"base_uri"`
-	TelegramAuthURI URL    `yaml:"telegram_auth_uri" validate:"required"`
+	Address             string               `yaml:"address"                validate:"required"`
+	BaseUri             URL                  `yaml:"base_uri"`
+	TelegramAuthURI     URL                  `yaml:"telegram_auth_uri"      validate:"required"`
+	TelegramDeepLinkURI URL                  `yaml:"telegram_deep_link_uri" validate:"required"`
+	TrustedProxies      TrustedProxiesConfig `yaml:"trusted_proxies"`
+}
+
+// TrustedProxiesConfig describes reverse proxies allowed to report the client IP address.
+// Without trusted proxies the peer address of the connection is used.
+type TrustedProxiesConfig struct {
+	CIDRs  []string `yaml:"cidrs"  validate:"dive,cidr"`
+	Header string   `yaml:"header" validate:"required,oneof=x-forwarded-for x-real-ip forwarded"`
+}
+
+// AdminServerTLSConfig represents TLS settings of the admin listener.
+type AdminServerTLSConfig struct {
+	CertFile     string `yaml:"cert_file"      validate:"required,file"`
+	KeyFile      string `yaml:"key_file"       validate:"required,file"`
+	ClientCAFile string `yaml:"client_ca_file" validate:"omitempty,file"` // Enables mTLS: clients must present a certificate issued by the CA
+}
+
+// AdminServerConfig represents the listener serving the private API only.
+// When it is configured, the private API is no longer served by the public listener.
+type AdminServerConfig struct {
+	Address    string                `yaml:"address"     validate:"required_without=UnixSocket,excluded_with=UnixSocket"`
+	UnixSocket string                `yaml:"unix_socket" validate:"required_without=Address"`
+	TLS        *AdminServerTLSConfig `yaml:"tls"`
 }
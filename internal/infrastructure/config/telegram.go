@@ -1,6 +1,10 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
 
 // TelegramTokenVerificationCacheConfig holds token verification cache settings.
 type TelegramTokenVerificationCacheConfig struct {
@@ -9,15 +13,48 @@ type TelegramTokenVerificationCacheConfig struct {
 	Secret string        `yaml:"secret" validate:"required"`
 }
 
+// TelegramChatMemberCacheConfig holds chat membership cache settings.
+type TelegramChatMemberCacheConfig struct {
+	Prefix string        `yaml:"prefix" validate:"required"`
+	TTL    time.Duration `yaml:"ttl"    validate:"required,gt=0"`
+}
+
 // TelegramReplayGuardConfig holds replay guard cache settings.
 type TelegramReplayGuardConfig struct {
 	Prefix string        `yaml:"prefix" validate:"required"`
 	TTL    time.Duration `yaml:"ttl"    validate:"required,gt=0"`
 }
 
+// TelegramMiniAppSignatureConfig holds Mini App third-party signature settings.
+// The Telegram test environment signs with 40055058a4ee38156a06562e52eece92a771bcd8346a8c4615cb7376eddf72ec.
+type TelegramMiniAppSignatureConfig struct {
+	PublicKey string `yaml:"public_key" validate:"required,hexadecimal,len=64"` // Hex-encoded Telegram Ed25519 public key
+}
+
+// TelegramBotLoginConfig holds bot deep link login settings.
+// WebhookSecret is only required once bot login or webhook registration is enabled.
+type TelegramBotLoginConfig struct {
+	Enabled         bool          `yaml:"enabled"` // Offer the bot login on the login page
+	Prefix          string        `yaml:"prefix"           validate:"required"`
+	TTL             time.Duration `yaml:"ttl"              validate:"required,gt=0"`
+	WebhookSecret   string        `yaml:"webhook_secret"`
+	RegisterWebhook bool          `yaml:"register_webhook"` // Point bot webhooks to the provider on sync
+}
+
+// validateTelegramBotLoginConfig requires the webhook secret when bot webhooks are in use.
+func validateTelegramBotLoginConfig(sl validator.StructLevel) {
+	cfg := sl.Current().Interface().(TelegramBotLoginConfig)
+	if (cfg.Enabled || cfg.RegisterWebhook) && cfg.WebhookSecret == "" {
+		sl.ReportError(cfg.WebhookSecret, "WebhookSecret", "webhook_secret", "required", "")
+	}
+}
+
 // TelegramSecurityConfig holds Telegram-related security settings.
 type TelegramSecurityConfig struct {
 	AuthDataTTLSeconds     time.Duration                        `yaml:"auth_data_ttl"            validate:"required,gt=0"`
 	TokenVerificationCache TelegramTokenVerificationCacheConfig `yaml:"token_verification_cache" validate:"required"`
+	ChatMemberCache        TelegramChatMemberCacheConfig        `yaml:"chat_member_cache"        validate:"required"`
 	ReplayGuard            TelegramReplayGuardConfig            `yaml:"replay_guard"             validate:"required"`
+	MiniAppSignature       TelegramMiniAppSignatureConfig       `yaml:"mini_app_signature"       validate:"required"`
+	BotLogin               TelegramBotLoginConfig               `yaml:"bot_login"                validate:"required"`
 }
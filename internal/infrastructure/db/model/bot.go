@@ -7,13 +7,25 @@ import (
 
 // Bot represents a Telegram bot in the database.
 type Bot struct {
-	Id        int64        `gorm:"column:id;primaryKey"`
-	Name      string       `gorm:"column:name;type:varchar(255);not null"`
-	ClientId  *string      `gorm:"column:client_id;type:varchar(255);uniqueIndex"`
-	Username  string       `gorm:"column:username;type:varchar(255);not null"`
-	Token     []byte       `gorm:"column:token;type:bytea;not null"`
-	CreatedAt time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt sql.NullTime `gorm:"column:updated_at"`
+	Id                    int64        `gorm:"column:id;primaryKey"`
+	Name                  string       `gorm:"column:name;type:varchar(255);not null"`
+	ClientId              *string      `gorm:"column:client_id;type:varchar(255);uniqueIndex"`
+	Username              string       `gorm:"column:username;type:varchar(255);not null"`
+	Token                 []byte       `gorm:"column:token;type:bytea;not null"`
+	InitDataVerification  string       `gorm:"column:init_data_verification;type:varchar(16);not null;default:hash"`
+	Description           string       `gorm:"column:description;type:varchar(512);not null;default:''"`
+	ShortDescription      string       `gorm:"column:short_description;type:varchar(120);not null;default:''"`
+	CanJoinGroups         bool         `gorm:"column:can_join_groups;not null;default:false"`
+	SupportsInlineQueries bool         `gorm:"column:supports_inline_queries;not null;default:false"`
+	PhotoFileUniqueId     *string      `gorm:"column:photo_file_unique_id;type:varchar(64)"`
+	Status                string       `gorm:"column:status;type:varchar(16);not null;default:active"`
+	StatusReason          *string      `gorm:"column:status_reason;type:varchar(512)"`
+	StatusChangedAt       sql.NullTime `gorm:"column:status_changed_at"`
+	CredentialsStatus     string       `gorm:"column:credentials_status;type:varchar(32);not null;default:valid"`
+	CredentialsCheckedAt  sql.NullTime `gorm:"column:credentials_checked_at"`
+	Version               int64        `gorm:"column:version;not null;default:1"`
+	CreatedAt             time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt             sql.NullTime `gorm:"column:updated_at"`
 }
 
 func (Bot) TableName() string { return "bots" }
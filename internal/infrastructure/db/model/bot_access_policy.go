@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// BotAccessPolicy represents the access policy of a bot in the database.
+type BotAccessPolicy struct {
+	BotId            int64        `gorm:"column:bot_id;primaryKey;not null"`
+	AllowedUserIds   []int64      `gorm:"column:allowed_user_ids;type:jsonb;serializer:json;not null"`
+	DeniedUserIds    []int64      `gorm:"column:denied_user_ids;type:jsonb;serializer:json;not null"`
+	RequireUsername  bool         `gorm:"column:require_username;not null"`
+	RequirePremium   bool         `gorm:"column:require_premium;not null"`
+	AllowedLanguages []string     `gorm:"column:allowed_languages;type:jsonb;serializer:json;not null"`
+	CreatedAt        time.Time    `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt        sql.NullTime `gorm:"column:updated_at"`
+}
+
+func (BotAccessPolicy) TableName() string { return "bot_access_policies" }
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// BotChat represents a Telegram chat bound to a bot in the database.
+type BotChat struct {
+	BotId     int64     `gorm:"column:bot_id;primaryKey;not null"`
+	ChatId    int64     `gorm:"column:chat_id;primaryKey;not null"`
+	GroupName string    `gorm:"column:group_name;type:varchar(64);not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (BotChat) TableName() string { return "bot_chats" }
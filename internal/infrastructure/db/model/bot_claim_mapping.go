@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// BotClaimMapping represents a scope-to-claim mapping of a bot in the database.
+type BotClaimMapping struct {
+	BotId     int64     `gorm:"column:bot_id;primaryKey;not null"`
+	Scope     string    `gorm:"column:scope;type:varchar(64);primaryKey;not null"`
+	Claim     string    `gorm:"column:claim;type:varchar(64);primaryKey;not null"`
+	Source    string    `gorm:"column:source;type:varchar(32);not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (BotClaimMapping) TableName() string { return "bot_claim_mappings" }
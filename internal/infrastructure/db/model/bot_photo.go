@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// BotPhoto represents the profile photo of a bot in the database.
+type BotPhoto struct {
+	BotId        int64     `gorm:"column:bot_id;primaryKey;not null"`
+	FileUniqueId string    `gorm:"column:file_unique_id;type:varchar(64);not null"`
+	Content      []byte    `gorm:"column:content;type:bytea;not null"`
+	ContentType  string    `gorm:"column:content_type;type:varchar(64);not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (BotPhoto) TableName() string { return "bot_photos" }
@@ -19,6 +19,7 @@ type BotUser struct {
 	UserAgent   sql.NullString `gorm:"column:user_agent;type:text"`
 	Language    sql.NullString `gorm:"column:language;type:varchar(10)"`
 	LastLoginAt time.Time      `gorm:"column:last_login_at;not null;default:CURRENT_TIMESTAMP"`
+	Version     int64          `gorm:"column:version;not null;default:1"`
 	CreatedAt   time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt   sql.NullTime   `gorm:"column:updated_at"`
 }
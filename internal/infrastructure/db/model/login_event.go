@@ -0,0 +1,23 @@
+package model
+
+import (
+	"database/sql"
+	"net/netip"
+	"time"
+)
+
+// LoginEvent represents a recorded login attempt in the database.
+type LoginEvent struct {
+	Id        int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	Method    string         `gorm:"column:method;type:varchar(32);not null"`
+	Outcome   string         `gorm:"column:outcome;type:varchar(16);not null"`
+	ClientId  sql.NullString `gorm:"column:client_id;type:varchar(255)"`
+	BotId     sql.NullInt64  `gorm:"column:bot_id"`
+	UserId    sql.NullInt64  `gorm:"column:user_id"`
+	ErrorCode sql.NullString `gorm:"column:error_code;type:varchar(64)"`
+	IP        netip.Addr     `gorm:"column:ip;type:inet"` // Zero when unknown
+	UserAgent sql.NullString `gorm:"column:user_agent;type:text"`
+	CreatedAt time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (LoginEvent) TableName() string { return "login_events" }
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormBotAccessPolicyRepository implements port.BotAccessPolicyRepositoryPort using GORM.
+type GormBotAccessPolicyRepository struct {
+	gormDB *gorm.DB
+}
+
+// Compile-time check that GormBotAccessPolicyRepository implements port.BotAccessPolicyRepositoryPort
+var _ repository.BotAccessPolicyRepositoryPort = (*GormBotAccessPolicyRepository)(nil)
+
+// NewBotAccessPolicyRepository creates a new GORM-based bot access policy repository.
+func NewBotAccessPolicyRepository(gormDB *gorm.DB) *GormBotAccessPolicyRepository {
+	return &GormBotAccessPolicyRepository{gormDB: gormDB}
+}
+
+// toDBModel converts entity.BotAccessPolicy to model.BotAccessPolicy.
+func (r *GormBotAccessPolicyRepository) toDBModel(policy *entity.BotAccessPolicy) *model.BotAccessPolicy {
+	dbPolicy := &model.BotAccessPolicy{
+		BotId:            policy.BotId,
+		AllowedUserIds:   policy.AllowedUserIds,
+		DeniedUserIds:    policy.DeniedUserIds,
+		RequireUsername:  policy.RequireUsername,
+		RequirePremium:   policy.RequirePremium,
+		AllowedLanguages: policy.AllowedLanguages,
+		CreatedAt:        policy.CreatedAt,
+	}
+
+	// Store empty lists rather than JSON null
+	if dbPolicy.AllowedUserIds == nil {
+		dbPolicy.AllowedUserIds = []int64{}
+	}
+	if dbPolicy.DeniedUserIds == nil {
+		dbPolicy.DeniedUserIds = []int64{}
+	}
+	if dbPolicy.AllowedLanguages == nil {
+		dbPolicy.AllowedLanguages = []string{}
+	}
+
+	if policy.UpdatedAt != nil {
+		dbPolicy.UpdatedAt = sql.NullTime{Time: *policy.UpdatedAt, Valid: true}
+	}
+
+	return dbPolicy
+}
+
+// toEntity converts model.BotAccessPolicy to entity.BotAccessPolicy.
+func (r *GormBotAccessPolicyRepository) toEntity(dbPolicy *model.BotAccessPolicy) *entity.BotAccessPolicy {
+	policy := &entity.BotAccessPolicy{
+		BotId:            dbPolicy.BotId,
+		AllowedUserIds:   dbPolicy.AllowedUserIds,
+		DeniedUserIds:    dbPolicy.DeniedUserIds,
+		RequireUsername:  dbPolicy.RequireUsername,
+		RequirePremium:   dbPolicy.RequirePremium,
+		AllowedLanguages: dbPolicy.AllowedLanguages,
+		CreatedAt:        dbPolicy.CreatedAt,
+	}
+
+	if dbPolicy.UpdatedAt.Valid {
+		policy.UpdatedAt = &dbPolicy.UpdatedAt.Time
+	}
+
+	return policy
+}
+
+// GetByBot retrieves the access policy of a bot and populates the provided policy pointer.
+func (r *GormBotAccessPolicyRepository) GetByBot(ctx context.Context, botID int64, policy *entity.BotAccessPolicy) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbPolicy model.BotAccessPolicy
+	if err := gormDB.WithContext(ctx).Where("bot_id = ?", botID).First(&dbPolicy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	*policy = *r.toEntity(&dbPolicy)
+	return nil
+}
+
+// Save creates or replaces the access policy of a bot and refreshes the provided policy pointer.
+// Replacing keeps the original creation time.
+func (r *GormBotAccessPolicyRepository) Save(ctx context.Context, policy *entity.BotAccessPolicy) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	dbPolicy := r.toDBModel(policy)
+
+	updates := clause.AssignmentColumns([]string{
+		"allowed_user_ids",
+		"denied_user_ids",
+		"require_username",
+		"require_premium",
+		"allowed_languages",
+	})
+	updates = append(updates, clause.Assignment{
+		Column: clause.Column{Name: "updated_at"},
+		Value:  gorm.Expr("CURRENT_TIMESTAMP"),
+	})
+
+	if err := gormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "bot_id"}},
+			DoUpdates: updates,
+		}).
+		Create(dbPolicy).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: bot does not exist", repository.ErrNotFound)
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	// Reload from DB to get the stored timestamps
+	return r.GetByBot(ctx, policy.BotId, policy)
+}
+
+// DeleteByBot removes the access policy of a bot.
+func (r *GormBotAccessPolicyRepository) DeleteByBot(ctx context.Context, botID int64) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	result := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Delete(&model.BotAccessPolicy{})
+
+	if result.Error != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
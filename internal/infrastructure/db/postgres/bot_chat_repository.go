@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"gorm.io/gorm"
+)
+
+// GormBotChatRepository implements port.BotChatRepositoryPort using GORM.
+type GormBotChatRepository struct {
+	gormDB *gorm.DB
+}
+
+// Compile-time check that GormBotChatRepository implements port.BotChatRepositoryPort
+var _ repository.BotChatRepositoryPort = (*GormBotChatRepository)(nil)
+
+// NewBotChatRepository creates a new GORM-based bot chat repository.
+func NewBotChatRepository(gormDB *gorm.DB) *GormBotChatRepository {
+	return &GormBotChatRepository{gormDB: gormDB}
+}
+
+// toDBModel converts entity.BotChat to model.BotChat.
+func (r *GormBotChatRepository) toDBModel(chat *entity.BotChat) *model.BotChat {
+	return &model.BotChat{
+		BotId:     chat.BotId,
+		ChatId:    chat.ChatId,
+		GroupName: chat.Group,
+		CreatedAt: chat.CreatedAt,
+	}
+}
+
+// toEntity converts model.BotChat to entity.BotChat.
+func (r *GormBotChatRepository) toEntity(dbChat *model.BotChat) *entity.BotChat {
+	return &entity.BotChat{
+		BotId:     dbChat.BotId,
+		ChatId:    dbChat.ChatId,
+		Group:     dbChat.GroupName,
+		CreatedAt: dbChat.CreatedAt,
+	}
+}
+
+// GetByBot retrieves all chats of a bot.
+func (r *GormBotChatRepository) GetByBot(ctx context.Context, botID int64) ([]*entity.BotChat, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbChats []model.BotChat
+	if err := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Order("group_name").
+		Find(&dbChats).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	chats := make([]*entity.BotChat, 0, len(dbChats))
+	for i := range dbChats {
+		chats = append(chats, r.toEntity(&dbChats[i]))
+	}
+
+	return chats, nil
+}
+
+// ReplaceByBot replaces all chats of a bot with the given ones.
+func (r *GormBotChatRepository) ReplaceByBot(ctx context.Context, botID int64, chats []*entity.BotChat) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	if err := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Delete(&model.BotChat{}).Error; err != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	if len(chats) == 0 {
+		return nil
+	}
+
+	dbChats := make([]*model.BotChat, 0, len(chats))
+	for _, chat := range chats {
+		dbChats = append(dbChats, r.toDBModel(chat))
+	}
+
+	if err := gormDB.WithContext(ctx).Create(dbChats).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return fmt.Errorf("%w: bot chat already exists", repository.ErrDuplicate)
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: bot does not exist", repository.ErrNotFound)
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return nil
+}
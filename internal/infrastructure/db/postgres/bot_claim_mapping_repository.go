@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"gorm.io/gorm"
+)
+
+// GormBotClaimMappingRepository implements port.BotClaimMappingRepositoryPort using GORM.
+type GormBotClaimMappingRepository struct {
+	gormDB *gorm.DB
+}
+
+// Compile-time check that GormBotClaimMappingRepository implements port.BotClaimMappingRepositoryPort
+var _ repository.BotClaimMappingRepositoryPort = (*GormBotClaimMappingRepository)(nil)
+
+// NewBotClaimMappingRepository creates a new GORM-based bot claim mapping repository.
+func NewBotClaimMappingRepository(gormDB *gorm.DB) *GormBotClaimMappingRepository {
+	return &GormBotClaimMappingRepository{gormDB: gormDB}
+}
+
+// toDBModel converts entity.BotClaimMapping to model.BotClaimMapping.
+func (r *GormBotClaimMappingRepository) toDBModel(mapping *entity.BotClaimMapping) *model.BotClaimMapping {
+	return &model.BotClaimMapping{
+		BotId:     mapping.BotId,
+		Scope:     mapping.Scope,
+		Claim:     mapping.Claim,
+		Source:    string(mapping.Source),
+		CreatedAt: mapping.CreatedAt,
+	}
+}
+
+// toEntity converts model.BotClaimMapping to entity.BotClaimMapping.
+func (r *GormBotClaimMappingRepository) toEntity(dbMapping *model.BotClaimMapping) *entity.BotClaimMapping {
+	return &entity.BotClaimMapping{
+		BotId:     dbMapping.BotId,
+		Scope:     dbMapping.Scope,
+		Claim:     dbMapping.Claim,
+		Source:    entity.ClaimSource(dbMapping.Source),
+		CreatedAt: dbMapping.CreatedAt,
+	}
+}
+
+// GetByBot retrieves all claim mappings of a bot.
+func (r *GormBotClaimMappingRepository) GetByBot(ctx context.Context, botID int64) ([]*entity.BotClaimMapping, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbMappings []model.BotClaimMapping
+	if err := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Order("scope, claim").
+		Find(&dbMappings).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	mappings := make([]*entity.BotClaimMapping, 0, len(dbMappings))
+	for i := range dbMappings {
+		mappings = append(mappings, r.toEntity(&dbMappings[i]))
+	}
+
+	return mappings, nil
+}
+
+// ReplaceByBot replaces all claim mappings of a bot with the given ones.
+func (r *GormBotClaimMappingRepository) ReplaceByBot(ctx context.Context, botID int64, mappings []*entity.BotClaimMapping) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	if err := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Delete(&model.BotClaimMapping{}).Error; err != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	dbMappings := make([]*model.BotClaimMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		dbMappings = append(dbMappings, r.toDBModel(mapping))
+	}
+
+	if err := gormDB.WithContext(ctx).Create(dbMappings).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return fmt.Errorf("%w: claim mapping already exists", repository.ErrDuplicate)
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: bot does not exist", repository.ErrNotFound)
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return nil
+}
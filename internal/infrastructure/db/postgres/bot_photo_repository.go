@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormBotPhotoRepository implements port.BotPhotoRepositoryPort using GORM.
+type GormBotPhotoRepository struct {
+	gormDB *gorm.DB
+}
+
+// Compile-time check that GormBotPhotoRepository implements port.BotPhotoRepositoryPort
+var _ repository.BotPhotoRepositoryPort = (*GormBotPhotoRepository)(nil)
+
+// NewBotPhotoRepository creates a new GORM-based bot photo repository.
+func NewBotPhotoRepository(gormDB *gorm.DB) *GormBotPhotoRepository {
+	return &GormBotPhotoRepository{gormDB: gormDB}
+}
+
+// toDBModel converts entity.BotPhoto to model.BotPhoto.
+func (r *GormBotPhotoRepository) toDBModel(photo *entity.BotPhoto) *model.BotPhoto {
+	return &model.BotPhoto{
+		BotId:        photo.BotId,
+		FileUniqueId: photo.FileUniqueId,
+		Content:      photo.Content,
+		ContentType:  photo.ContentType,
+		CreatedAt:    photo.CreatedAt,
+	}
+}
+
+// toEntity converts model.BotPhoto to entity.BotPhoto.
+func (r *GormBotPhotoRepository) toEntity(dbPhoto *model.BotPhoto) *entity.BotPhoto {
+	return &entity.BotPhoto{
+		BotId:        dbPhoto.BotId,
+		FileUniqueId: dbPhoto.FileUniqueId,
+		Content:      dbPhoto.Content,
+		ContentType:  dbPhoto.ContentType,
+		CreatedAt:    dbPhoto.CreatedAt,
+	}
+}
+
+// GetByBot retrieves the profile photo of a bot and populates the provided pointer.
+func (r *GormBotPhotoRepository) GetByBot(ctx context.Context, botID int64, photo *entity.BotPhoto) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbPhoto model.BotPhoto
+	if err := gormDB.WithContext(ctx).Where("bot_id = ?", botID).First(&dbPhoto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	*photo = *r.toEntity(&dbPhoto)
+	return nil
+}
+
+// Save creates or replaces the profile photo of a bot.
+func (r *GormBotPhotoRepository) Save(ctx context.Context, photo *entity.BotPhoto) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	if err := gormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "bot_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"file_unique_id", "content", "content_type", "created_at"}),
+		}).
+		Create(r.toDBModel(photo)).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: bot does not exist", repository.ErrNotFound)
+		}
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// DeleteByBot removes the profile photo of a bot. Deleting a missing photo is not an error.
+func (r *GormBotPhotoRepository) DeleteByBot(ctx context.Context, botID int64) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	if err := gormDB.WithContext(ctx).
+		Where("bot_id = ?", botID).
+		Delete(&model.BotPhoto{}).Error; err != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return nil
+}
@@ -2,103 +2,95 @@ package postgres
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
 	"database/sql"
 	"errors"
 	"fmt"
-	"io"
+	"strings"
 
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/keyring"
 	"gorm.io/gorm"
 )
 
 // GormBotRepository implements port.BotRepositoryPort using GORM.
 type GormBotRepository struct {
-	gormDB        *gorm.DB
-	encryptionKey []byte // AES-256 key (32 bytes)
+	gormDB  *gorm.DB
+	keyring *keyring.Keyring // Encrypts tokens with AES-256-GCM
 }
 
 // Compile-time check that GormBotRepository implements port.BotRepositoryPort
 var _ repository.BotRepositoryPort = (*GormBotRepository)(nil)
 
 // NewBotRepository creates a new GORM-based bot repository with token encryption.
-func NewBotRepository(gormDB *gorm.DB, encryptionKey []byte) (*GormBotRepository, error) {
-	if len(encryptionKey) != 32 {
-		return nil, errors.New("encryption key must be 32 bytes for AES-256")
+func NewBotRepository(gormDB *gorm.DB, tokenKeyring *keyring.Keyring) (*GormBotRepository, error) {
+	if tokenKeyring == nil {
+		return nil, errors.New("token keyring cannot be nil")
 	}
 	return &GormBotRepository{
-		gormDB:        gormDB,
-		encryptionKey: encryptionKey,
+		gormDB:  gormDB,
+		keyring: tokenKeyring,
 	}, nil
 }
 
-// encryptToken encrypts a bot token using AES-256-GCM.
+// encryptToken encrypts a bot token with the active key of the keyring.
 func (r *GormBotRepository) encryptToken(token string) ([]byte, error) {
-	block, err := aes.NewCipher(r.encryptionKey)
+	ciphertext, err := r.keyring.Encrypt([]byte(token))
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create cipher: %v", repository.ErrEncryptionFailed, err)
+		return nil, fmt.Errorf("%w: %v", repository.ErrEncryptionFailed, err)
 	}
-
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create GCM: %v", repository.ErrEncryptionFailed, err)
-	}
-
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, fmt.Errorf("%w: failed to generate nonce: %v", repository.ErrEncryptionFailed, err)
-	}
-
-	ciphertext := gcm.Seal(nonce, nonce, []byte(token), nil)
 	return ciphertext, nil
 }
 
-// decryptToken decrypts a bot token using AES-256-GCM.
+// decryptToken decrypts a bot token encrypted with any key of the keyring.
 func (r *GormBotRepository) decryptToken(encrypted []byte) (string, error) {
-	block, err := aes.NewCipher(r.encryptionKey)
-	if err != nil {
-		return "", fmt.Errorf("%w: failed to create cipher: %v", repository.ErrEncryptionFailed, err)
-	}
-
-	gcm, err := cipher.NewGCM(block)
+	plaintext, err := r.keyring.Decrypt(encrypted)
 	if err != nil {
-		return "", fmt.Errorf("%w: failed to create GCM: %v", repository.ErrEncryptionFailed, err)
+		return "", fmt.Errorf("%w: %v", repository.ErrEncryptionFailed, err)
 	}
-
-	nonceSize := gcm.NonceSize()
-	if len(encrypted) < nonceSize {
-		return "", fmt.Errorf("%w: token data too short", repository.ErrEncryptionFailed)
-	}
-
-	nonce, ciphertext := encrypted[:nonceSize], encrypted[nonceSize:]
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
-	if err != nil {
-		return "", fmt.Errorf("%w: failed to decrypt: %v", repository.ErrEncryptionFailed, err)
-	}
-
 	return string(plaintext), nil
 }
 
-// toDBModel converts entity.Bot to model.Bot with token encryption.
+// toDBModel converts entity.Bot to model.Bot with token encryption. The token is left
+// nil when it was never loaded, since it is unchanged then.
 func (r *GormBotRepository) toDBModel(bot *entity.Bot) (*model.Bot, error) {
-	encryptedToken, err := r.encryptToken(bot.Token)
-	if err != nil {
-		return nil, err
+	var encryptedToken []byte
+	if bot.IsTokenLoaded() {
+		token, err := bot.Token()
+		if err != nil {
+			return nil, err
+		}
+		if encryptedToken, err = r.encryptToken(token); err != nil {
+			return nil, err
+		}
 	}
 
 	dbBot := &model.Bot{
-		Id:        bot.Id,
-		Name:      bot.Name,
-		ClientId:  bot.ClientId,
-		Username:  bot.Username,
-		Token:     encryptedToken,
-		CreatedAt: bot.CreatedAt,
+		Id:                    bot.Id,
+		Name:                  bot.Name,
+		ClientId:              bot.ClientId,
+		Username:              bot.Username,
+		Token:                 encryptedToken,
+		InitDataVerification:  string(bot.InitDataVerification),
+		Description:           bot.Description,
+		ShortDescription:      bot.ShortDescription,
+		CanJoinGroups:         bot.CanJoinGroups,
+		SupportsInlineQueries: bot.SupportsInlineQueries,
+		PhotoFileUniqueId:     bot.PhotoFileUniqueId,
+		Status:                string(bot.Status),
+		StatusReason:          bot.StatusReason,
+		CredentialsStatus:     string(bot.CredentialsStatus),
+		Version:               bot.Version,
+		CreatedAt:             bot.CreatedAt,
 	}
 
+	if bot.StatusChangedAt != nil {
+		dbBot.StatusChangedAt = sql.NullTime{Time: *bot.StatusChangedAt, Valid: true}
+	}
+	if bot.CredentialsCheckedAt != nil {
+		dbBot.CredentialsCheckedAt = sql.NullTime{Time: *bot.CredentialsCheckedAt, Valid: true}
+	}
 	if bot.UpdatedAt != nil {
 		dbBot.UpdatedAt = sql.NullTime{Time: *bot.UpdatedAt, Valid: true}
 	}
@@ -106,25 +98,40 @@ func (r *GormBotRepository) toDBModel(bot *entity.Bot) (*model.Bot, error) {
 	return dbBot, nil
 }
 
-// toEntity converts model.Bot to entity.Bot with token decryption.
+// toEntity converts model.Bot to entity.Bot. The token is decrypted on first use.
 func (r *GormBotRepository) toEntity(dbBot *model.Bot) (*entity.Bot, error) {
-	decryptedToken, err := r.decryptToken(dbBot.Token)
-	if err != nil {
-		return nil, err
-	}
+	encryptedToken := dbBot.Token
 
 	bot := &entity.Bot{
-		Id:        dbBot.Id,
-		Name:      dbBot.Name,
-		ClientId:  dbBot.ClientId,
-		Username:  dbBot.Username,
-		Token:     decryptedToken,
-		CreatedAt: dbBot.CreatedAt,
+		Id:                    dbBot.Id,
+		Name:                  dbBot.Name,
+		ClientId:              dbBot.ClientId,
+		Username:              dbBot.Username,
+		InitDataVerification:  entity.BotInitDataVerification(dbBot.InitDataVerification),
+		Description:           dbBot.Description,
+		ShortDescription:      dbBot.ShortDescription,
+		CanJoinGroups:         dbBot.CanJoinGroups,
+		SupportsInlineQueries: dbBot.SupportsInlineQueries,
+		PhotoFileUniqueId:     dbBot.PhotoFileUniqueId,
+		Status:                entity.BotStatus(dbBot.Status),
+		StatusReason:          dbBot.StatusReason,
+		CredentialsStatus:     entity.BotCredentialsStatus(dbBot.CredentialsStatus),
+		Version:               dbBot.Version,
+		CreatedAt:             dbBot.CreatedAt,
 	}
 
+	if dbBot.StatusChangedAt.Valid {
+		bot.StatusChangedAt = &dbBot.StatusChangedAt.Time
+	}
+	if dbBot.CredentialsCheckedAt.Valid {
+		bot.CredentialsCheckedAt = &dbBot.CredentialsCheckedAt.Time
+	}
 	if dbBot.UpdatedAt.Valid {
 		bot.UpdatedAt = &dbBot.UpdatedAt.Time
 	}
+	bot.SetTokenLoader(func() (string, error) {
+		return r.decryptToken(encryptedToken)
+	})
 
 	return bot, nil
 }
@@ -183,6 +190,42 @@ func (r *GormBotRepository) GetByClientID(ctx context.Context, clientID string,
 	return nil
 }
 
+// List retrieves bots matching the filter ordered by ID.
+func (r *GormBotRepository) List(ctx context.Context, filter *repository.BotListFilter) ([]*entity.Bot, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	query := gormDB.WithContext(ctx).Model(&model.Bot{})
+	if filter.Linked != nil {
+		if *filter.Linked {
+			query = query.Where("client_id IS NOT NULL")
+		} else {
+			query = query.Where("client_id IS NULL")
+		}
+	}
+	if filter.UsernamePrefix != nil && *filter.UsernamePrefix != "" {
+		query = query.Where("lower(username) LIKE ?", strings.ToLower(escapeLike(*filter.UsernamePrefix))+"%")
+	}
+	if filter.AfterId != nil {
+		query = query.Where("id > ?", *filter.AfterId)
+	}
+
+	var dbBots []model.Bot
+	if err := query.Order("id ASC").Limit(filter.Limit).Find(&dbBots).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	bots := make([]*entity.Bot, 0, len(dbBots))
+	for i := range dbBots {
+		bot, err := r.toEntity(&dbBots[i])
+		if err != nil {
+			return nil, err
+		}
+		bots = append(bots, bot)
+	}
+
+	return bots, nil
+}
+
 // Create stores a new bot and updates the provided bot pointer with inserted data.
 func (r *GormBotRepository) Create(ctx context.Context, bot *entity.Bot) error {
 	gormDB := GetTx(ctx, r.gormDB)
@@ -193,8 +236,9 @@ func (r *GormBotRepository) Create(ctx context.Context, bot *entity.Bot) error {
 	}
 
 	if err := gormDB.WithContext(ctx).Create(dbBot).Error; err != nil {
+		// Both the id and the client_id are unique
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
-			return fmt.Errorf("%w: client_id already exists", repository.ErrDuplicate)
+			return fmt.Errorf("%w: bot or client_id already exists", repository.ErrDuplicate)
 		}
 		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
 	}
@@ -210,6 +254,8 @@ func (r *GormBotRepository) Create(ctx context.Context, bot *entity.Bot) error {
 }
 
 // Update updates an existing bot and refreshes the provided bot pointer.
+// The update only applies when the stored version matches bot.Version, otherwise
+// repository.ErrConcurrentModification is returned.
 func (r *GormBotRepository) Update(ctx context.Context, bot *entity.Bot) error {
 	gormDB := GetTx(ctx, r.gormDB)
 
@@ -217,8 +263,20 @@ func (r *GormBotRepository) Update(ctx context.Context, bot *entity.Bot) error {
 	if err != nil {
 		return err
 	}
+	dbBot.Version = bot.Version + 1
 
-	result := gormDB.WithContext(ctx).Model(&model.Bot{}).Where("id = ?", bot.Id).Updates(dbBot)
+	// Select all columns so that clearing nullable fields (e.g. client_id) is persisted. The credentials
+	// status is only written by UpdateCredentialsStatus, so that a stale read never overwrites it
+	omit := []string{"id", "created_at", "credentials_status", "credentials_checked_at"}
+	if dbBot.Token == nil {
+		omit = append(omit, "token")
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&model.Bot{}).
+		Where("id = ? AND version = ?", bot.Id, bot.Version).
+		Select("*").
+		Omit(omit...).
+		Updates(dbBot)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
 			return fmt.Errorf("%w: client_id already exists", repository.ErrDuplicate)
@@ -226,7 +284,14 @@ func (r *GormBotRepository) Update(ctx context.Context, bot *entity.Bot) error {
 		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("%w: %v", repository.ErrNotFound, err)
+		exists, err := r.ExistsByID(ctx, bot.Id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("%w: bot version %d is stale", repository.ErrConcurrentModification, bot.Version)
 	}
 
 	// Reload from DB to get updated fields
@@ -247,6 +312,44 @@ func (r *GormBotRepository) Update(ctx context.Context, bot *entity.Bot) error {
 	return nil
 }
 
+// UpdateCredentialsStatus stores the credentials status of the bot. The update only applies when
+// the bot was not modified since it was read, otherwise repository.ErrConcurrentModification is returned.
+func (r *GormBotRepository) UpdateCredentialsStatus(ctx context.Context, bot *entity.Bot) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var updatedAt, checkedAt sql.NullTime
+	if bot.UpdatedAt != nil {
+		updatedAt = sql.NullTime{Time: *bot.UpdatedAt, Valid: true}
+	}
+	if bot.CredentialsCheckedAt != nil {
+		checkedAt = sql.NullTime{Time: *bot.CredentialsCheckedAt, Valid: true}
+	}
+
+	// A bot modified meanwhile may have a new token, which the status does not refer to
+	result := gormDB.WithContext(ctx).
+		Model(&model.Bot{}).
+		Where("id = ? AND updated_at IS NOT DISTINCT FROM ?", bot.Id, updatedAt).
+		UpdateColumns(map[string]any{
+			"credentials_status":     string(bot.CredentialsStatus),
+			"credentials_checked_at": checkedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		exists, err := r.ExistsByID(ctx, bot.Id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("%w: bot %d was modified", repository.ErrConcurrentModification, bot.Id)
+	}
+
+	return nil
+}
+
 // Delete removes a bot by ID.
 func (r *GormBotRepository) Delete(ctx context.Context, id int64) error {
 	gormDB := GetTx(ctx, r.gormDB)
@@ -264,3 +367,61 @@ func (r *GormBotRepository) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// ReencryptTokens re-encrypts with the active key every bot token encrypted with another key,
+// in batches ordered by id. Tokens changed concurrently are skipped, since every write already
+// uses the active key. Returns the number of re-encrypted tokens.
+func (r *GormBotRepository) ReencryptTokens(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, errors.New("batch size must be positive")
+	}
+
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var (
+		lastId      int64
+		reencrypted int
+	)
+	for {
+		var dbBots []model.Bot
+		if err := gormDB.WithContext(ctx).
+			Select("id", "token").
+			Where("id > ?", lastId).
+			Order("id ASC").
+			Limit(batchSize).
+			Find(&dbBots).Error; err != nil {
+			return reencrypted, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+		}
+		if len(dbBots) == 0 {
+			return reencrypted, nil
+		}
+
+		for _, dbBot := range dbBots {
+			lastId = dbBot.Id
+			if r.keyring.IsActive(dbBot.Token) {
+				continue
+			}
+
+			token, err := r.decryptToken(dbBot.Token)
+			if err != nil {
+				return reencrypted, fmt.Errorf("bot %d: %w", dbBot.Id, err)
+			}
+			encryptedToken, err := r.encryptToken(token)
+			if err != nil {
+				return reencrypted, fmt.Errorf("bot %d: %w", dbBot.Id, err)
+			}
+
+			// Compare with the old ciphertext so that a concurrent token update is not overwritten
+			result := gormDB.WithContext(ctx).
+				Model(&model.Bot{}).
+				Where("id = ? AND token = ?", dbBot.Id, dbBot.Token).
+				UpdateColumn("token", encryptedToken)
+			if result.Error != nil {
+				return reencrypted, fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+			}
+			if result.RowsAffected > 0 {
+				reencrypted++
+			}
+		}
+	}
+}
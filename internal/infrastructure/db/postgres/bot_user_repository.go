@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
@@ -34,6 +35,7 @@ func (r *GormBotUserRepository) toDBModel(botUser *entity.BotUser) *model.BotUse
 		FirstName:   botUser.User.FirstName,
 		IP:          botUser.IP,
 		LastLoginAt: botUser.LastLoginAt,
+		Version:     botUser.Version,
 		CreatedAt:   botUser.CreatedAt,
 	}
 
@@ -101,6 +103,7 @@ func (r *GormBotUserRepository) toEntity(dbBotUser *model.BotUser) (*entity.BotU
 		User:        user,
 		IP:          dbBotUser.IP,
 		LastLoginAt: dbBotUser.LastLoginAt,
+		Version:     dbBotUser.Version,
 		CreatedAt:   dbBotUser.CreatedAt,
 	}
 
@@ -161,6 +164,69 @@ func (r *GormBotUserRepository) GetByBot(ctx context.Context, botID int64) ([]*e
 	return botUsers, nil
 }
 
+// GetByUser retrieves the user across all bots.
+func (r *GormBotUserRepository) GetByUser(ctx context.Context, userID int64) ([]*entity.BotUser, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbBotUsers []model.BotUser
+	if err := gormDB.WithContext(ctx).Where("user_id = ?", userID).Order("bot_id ASC").Find(&dbBotUsers).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	botUsers := make([]*entity.BotUser, 0, len(dbBotUsers))
+	for i := range dbBotUsers {
+		botUser, err := r.toEntity(&dbBotUsers[i])
+		if err != nil {
+			return nil, err
+		}
+		botUsers = append(botUsers, botUser)
+	}
+
+	return botUsers, nil
+}
+
+// List retrieves bot users matching the filter ordered by last login and user ID.
+func (r *GormBotUserRepository) List(ctx context.Context, filter *repository.BotUserListFilter) ([]*entity.BotUser, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	query := gormDB.WithContext(ctx).Model(&model.BotUser{}).Where("bot_id = ?", filter.BotId)
+	if filter.Search != nil && *filter.Search != "" {
+		pattern := "%" + strings.ToLower(escapeLike(*filter.Search)) + "%"
+		query = query.Where(
+			"(lower(username) LIKE ? OR lower(first_name) LIKE ? OR lower(last_name) LIKE ?)",
+			pattern, pattern, pattern,
+		)
+	}
+
+	order := "last_login_at DESC, user_id DESC"
+	if filter.Ascending {
+		order = "last_login_at ASC, user_id ASC"
+	}
+	if filter.After != nil {
+		if filter.Ascending {
+			query = query.Where("(last_login_at, user_id) > (?, ?)", filter.After.LastLoginAt, filter.After.UserId)
+		} else {
+			query = query.Where("(last_login_at, user_id) < (?, ?)", filter.After.LastLoginAt, filter.After.UserId)
+		}
+	}
+
+	var dbBotUsers []model.BotUser
+	if err := query.Order(order).Limit(filter.Limit).Find(&dbBotUsers).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	botUsers := make([]*entity.BotUser, 0, len(dbBotUsers))
+	for i := range dbBotUsers {
+		botUser, err := r.toEntity(&dbBotUsers[i])
+		if err != nil {
+			return nil, err
+		}
+		botUsers = append(botUsers, botUser)
+	}
+
+	return botUsers, nil
+}
+
 // Create stores a new bot user and updates the provided botUser pointer with inserted data.
 func (r *GormBotUserRepository) Create(ctx context.Context, botUser *entity.BotUser) error {
 	gormDB := GetTx(ctx, r.gormDB)
@@ -188,21 +254,37 @@ func (r *GormBotUserRepository) Create(ctx context.Context, botUser *entity.BotU
 }
 
 // Update updates an existing bot user and refreshes the provided botUser pointer.
+// The update only applies when the stored version matches botUser.Version, otherwise
+// repository.ErrConcurrentModification is returned.
 func (r *GormBotUserRepository) Update(ctx context.Context, botUser *entity.BotUser) error {
 	gormDB := GetTx(ctx, r.gormDB)
 
 	dbBotUser := r.toDBModel(botUser)
+	dbBotUser.Version = botUser.Version + 1
 
+	// Select all columns so that cleared nullable fields are written as NULL.
 	result := gormDB.WithContext(ctx).
 		Model(&model.BotUser{}).
-		Where("bot_id = ? AND user_id = ?", botUser.BotId, botUser.UserId).
+		Where("bot_id = ? AND user_id = ? AND version = ?", botUser.BotId, botUser.UserId, botUser.Version).
+		Select("*").
+		Omit("bot_id", "user_id", "created_at").
 		Updates(dbBotUser)
 
 	if result.Error != nil {
 		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return repository.ErrNotFound
+		var count int64
+		if err := gormDB.WithContext(ctx).
+			Model(&model.BotUser{}).
+			Where("bot_id = ? AND user_id = ?", botUser.BotId, botUser.UserId).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+		}
+		if count == 0 {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("%w: bot user version %d is stale", repository.ErrConcurrentModification, botUser.Version)
 	}
 
 	// Reload from DB to get updated fields
@@ -242,3 +324,17 @@ func (r *GormBotUserRepository) Delete(ctx context.Context, botID, userID int64)
 
 	return nil
 }
+
+// DeleteByUser removes the user across all bots and returns the number of removed rows.
+func (r *GormBotUserRepository) DeleteByUser(ctx context.Context, userID int64) (int64, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	result := gormDB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.BotUser{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/model"
+	"gorm.io/gorm"
+)
+
+// GormLoginEventRepository implements port.LoginEventRepositoryPort using GORM.
+type GormLoginEventRepository struct {
+	gormDB *gorm.DB
+}
+
+// Compile-time check that GormLoginEventRepository implements port.LoginEventRepositoryPort
+var _ repository.LoginEventRepositoryPort = (*GormLoginEventRepository)(nil)
+
+// NewLoginEventRepository creates a new GORM-based login event repository.
+func NewLoginEventRepository(gormDB *gorm.DB) *GormLoginEventRepository {
+	return &GormLoginEventRepository{gormDB: gormDB}
+}
+
+// toDBModel converts entity.LoginEvent to model.LoginEvent.
+func (r *GormLoginEventRepository) toDBModel(event *entity.LoginEvent) *model.LoginEvent {
+	dbEvent := &model.LoginEvent{
+		Id:        event.Id,
+		Method:    string(event.Method),
+		Outcome:   string(event.Outcome),
+		CreatedAt: event.CreatedAt,
+	}
+
+	if event.ClientId != nil {
+		dbEvent.ClientId = sql.NullString{String: *event.ClientId, Valid: true}
+	}
+
+	if event.BotId != nil {
+		dbEvent.BotId = sql.NullInt64{Int64: *event.BotId, Valid: true}
+	}
+
+	if event.UserId != nil {
+		dbEvent.UserId = sql.NullInt64{Int64: *event.UserId, Valid: true}
+	}
+
+	if event.ErrorCode != nil {
+		dbEvent.ErrorCode = sql.NullString{String: *event.ErrorCode, Valid: true}
+	}
+
+	if event.IP != nil {
+		dbEvent.IP = *event.IP
+	}
+
+	if event.UserAgent != nil {
+		dbEvent.UserAgent = sql.NullString{String: *event.UserAgent, Valid: true}
+	}
+
+	return dbEvent
+}
+
+// toEntity converts model.LoginEvent to entity.LoginEvent.
+func (r *GormLoginEventRepository) toEntity(dbEvent *model.LoginEvent) *entity.LoginEvent {
+	event := &entity.LoginEvent{
+		Id:        dbEvent.Id,
+		Method:    entity.LoginMethod(dbEvent.Method),
+		Outcome:   entity.LoginOutcome(dbEvent.Outcome),
+		CreatedAt: dbEvent.CreatedAt,
+	}
+
+	if dbEvent.ClientId.Valid {
+		event.ClientId = &dbEvent.ClientId.String
+	}
+
+	if dbEvent.BotId.Valid {
+		event.BotId = &dbEvent.BotId.Int64
+	}
+
+	if dbEvent.UserId.Valid {
+		event.UserId = &dbEvent.UserId.Int64
+	}
+
+	if dbEvent.ErrorCode.Valid {
+		event.ErrorCode = &dbEvent.ErrorCode.String
+	}
+
+	if dbEvent.IP.IsValid() {
+		event.IP = &dbEvent.IP
+	}
+
+	if dbEvent.UserAgent.Valid {
+		event.UserAgent = &dbEvent.UserAgent.String
+	}
+
+	return event
+}
+
+func (r *GormLoginEventRepository) toEntities(dbEvents []model.LoginEvent) []*entity.LoginEvent {
+	events := make([]*entity.LoginEvent, 0, len(dbEvents))
+	for i := range dbEvents {
+		events = append(events, r.toEntity(&dbEvents[i]))
+	}
+	return events
+}
+
+// Create stores a new login event and updates the provided event pointer with inserted data.
+func (r *GormLoginEventRepository) Create(ctx context.Context, event *entity.LoginEvent) error {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	dbEvent := r.toDBModel(event)
+	dbEvent.Id = 0
+
+	if err := gormDB.WithContext(ctx).Create(dbEvent).Error; err != nil {
+		return fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	*event = *r.toEntity(dbEvent)
+	return nil
+}
+
+// GetByUser retrieves all events of the Telegram user ordered by ID.
+func (r *GormLoginEventRepository) GetByUser(ctx context.Context, userID int64) ([]*entity.LoginEvent, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	var dbEvents []model.LoginEvent
+	if err := gormDB.WithContext(ctx).Where("user_id = ?", userID).Order("id ASC").Find(&dbEvents).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return r.toEntities(dbEvents), nil
+}
+
+// List retrieves login events matching the filter from the latest one.
+func (r *GormLoginEventRepository) List(ctx context.Context, filter *repository.LoginEventListFilter) ([]*entity.LoginEvent, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	query := gormDB.WithContext(ctx).Model(&model.LoginEvent{})
+	if filter.BotId != nil {
+		query = query.Where("bot_id = ?", *filter.BotId)
+	}
+	if filter.UserId != nil {
+		query = query.Where("user_id = ?", *filter.UserId)
+	}
+	if filter.ClientId != nil {
+		query = query.Where("client_id = ?", *filter.ClientId)
+	}
+	if filter.Outcome != nil {
+		query = query.Where("outcome = ?", string(*filter.Outcome))
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("created_at < ?", *filter.Until)
+	}
+	if filter.BeforeId != nil {
+		query = query.Where("id < ?", *filter.BeforeId)
+	}
+
+	var dbEvents []model.LoginEvent
+	if err := query.Order("id DESC").Limit(filter.Limit).Find(&dbEvents).Error; err != nil {
+		return nil, fmt.Errorf("%w: %v", repository.ErrDatabaseError, err)
+	}
+
+	return r.toEntities(dbEvents), nil
+}
+
+// DeleteByUser removes all events of the Telegram user and returns the number of removed rows.
+func (r *GormLoginEventRepository) DeleteByUser(ctx context.Context, userID int64) (int64, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	result := gormDB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.LoginEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteBefore removes up to limit events created before the given time, oldest first,
+// and returns the number of removed rows.
+func (r *GormLoginEventRepository) DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	gormDB := GetTx(ctx, r.gormDB)
+
+	ids := gormDB.WithContext(ctx).
+		Model(&model.LoginEvent{}).
+		Select("id").
+		Where("created_at < ?", before).
+		Order("created_at").
+		Limit(limit)
+
+	result := gormDB.WithContext(ctx).
+		Where("id IN (?)", ids).
+		Delete(&model.LoginEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("%w: %v", repository.ErrDatabaseError, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,10 @@
+package postgres
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes LIKE wildcards so that the value is matched literally.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
@@ -1,22 +1,49 @@
 package di
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
+	"net/netip"
 	"net/url"
+	"os"
 	"strings"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
 	echo_middleware "github.com/oapi-codegen/echo-middleware"
 	"github.com/samber/do/v2"
 	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/adminauth"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/config"
 	apihttp "github.com/ulbwa/telegram-oidc-provider/internal/interface/http/api"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
 	webhttp "github.com/ulbwa/telegram-oidc-provider/internal/interface/http/web"
 )
 
+const (
+	// AdminEchoApp names the echo application serving the private API on the admin listener.
+	AdminEchoApp = "admin_echo_app"
+
+	// AdminListener names the listener of the admin echo application.
+	AdminListener = "admin_listener"
+)
+
+// apiRoutes selects the API operations served by an echo application.
+type apiRoutes int
+
+const (
+	allAPIRoutes apiRoutes = iota
+	publicAPIRoutes
+	privateAPIRoutes
+)
+
 func provideEchoApp(injector do.Injector) {
-	do.Provide(injector, func(i do.Injector) (*echo.Echo, error) {
+	do.Provide(injector, func(i do.Injector) (generated.StrictServerInterface, error) {
 		cfg, err := do.Invoke[*config.Config](i)
 		if err != nil {
 			return nil, err
@@ -32,7 +59,117 @@ func provideEchoApp(injector do.Injector) {
 			return nil, err
 		}
 
-		resolveLoginChallenge, err := do.Invoke[*usecase.ResolveLoginChallenge](i)
+		loginByMiniApp, err := do.Invoke[*usecase.LoginByMiniApp](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginByBot, err := do.Invoke[*usecase.LoginByBot](i)
+		if err != nil {
+			return nil, err
+		}
+
+		confirmLoginByBot, err := do.Invoke[*usecase.ConfirmLoginByBot](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBotClaimMappings, err := do.Invoke[*usecase.GetBotClaimMappings](i)
+		if err != nil {
+			return nil, err
+		}
+
+		setBotClaimMappings, err := do.Invoke[*usecase.SetBotClaimMappings](i)
+		if err != nil {
+			return nil, err
+		}
+
+		linkBotToClient, err := do.Invoke[*usecase.LinkBotToClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		unlinkBotFromClient, err := do.Invoke[*usecase.UnlinkBotFromClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		createBotClient, err := do.Invoke[*usecase.CreateBotClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBot, err := do.Invoke[*usecase.GetBot](i)
+		if err != nil {
+			return nil, err
+		}
+
+		listBots, err := do.Invoke[*usecase.ListBots](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteBot, err := do.Invoke[*usecase.DeleteBot](i)
+		if err != nil {
+			return nil, err
+		}
+
+		listBotUsers, err := do.Invoke[*usecase.ListBotUsers](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBotUser, err := do.Invoke[*usecase.GetBotUser](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteBotUser, err := do.Invoke[*usecase.DeleteBotUser](i)
+		if err != nil {
+			return nil, err
+		}
+
+		exportUserData, err := do.Invoke[*usecase.ExportUserData](i)
+		if err != nil {
+			return nil, err
+		}
+
+		eraseUserData, err := do.Invoke[*usecase.EraseUserData](i)
+		if err != nil {
+			return nil, err
+		}
+
+		listLoginEvents, err := do.Invoke[*usecase.ListLoginEvents](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBotAccessPolicy, err := do.Invoke[*usecase.GetBotAccessPolicy](i)
+		if err != nil {
+			return nil, err
+		}
+
+		setBotAccessPolicy, err := do.Invoke[*usecase.SetBotAccessPolicy](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteBotAccessPolicy, err := do.Invoke[*usecase.DeleteBotAccessPolicy](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBotChats, err := do.Invoke[*usecase.GetBotChats](i)
+		if err != nil {
+			return nil, err
+		}
+
+		setBotChats, err := do.Invoke[*usecase.SetBotChats](i)
+		if err != nil {
+			return nil, err
+		}
+
+		setBotStatus, err := do.Invoke[*usecase.SetBotStatus](i)
 		if err != nil {
 			return nil, err
 		}
@@ -48,14 +185,112 @@ func provideEchoApp(injector do.Injector) {
 			baseUri = uri
 		}
 
-		apiServer, err := apihttp.NewServer(baseUri, syncBot, loginByWidget)
+		return apihttp.NewServer(
+			baseUri,
+			syncBot,
+			loginByWidget,
+			loginByMiniApp,
+			loginByBot,
+			confirmLoginByBot,
+			getBotClaimMappings,
+			setBotClaimMappings,
+			linkBotToClient,
+			unlinkBotFromClient,
+			createBotClient,
+			getBot,
+			listBots,
+			deleteBot,
+			listBotUsers,
+			getBotUser,
+			deleteBotUser,
+			exportUserData,
+			eraseUserData,
+			listLoginEvents,
+			getBotAccessPolicy,
+			setBotAccessPolicy,
+			deleteBotAccessPolicy,
+			getBotChats,
+			setBotChats,
+			setBotStatus,
+		)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*echo.Echo, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		resolveLoginChallenge, err := do.Invoke[*usecase.ResolveLoginChallenge](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginByLoginUrl, err := do.Invoke[*usecase.LoginByLoginUrl](i)
 		if err != nil {
 			return nil, err
 		}
 
+		resolveConsentChallenge, err := do.Invoke[*usecase.ResolveConsentChallenge](i)
+		if err != nil {
+			return nil, err
+		}
+
+		acceptConsent, err := do.Invoke[*usecase.AcceptConsent](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rejectConsent, err := do.Invoke[*usecase.RejectConsent](i)
+		if err != nil {
+			return nil, err
+		}
+
+		resolveLogoutChallenge, err := do.Invoke[*usecase.ResolveLogoutChallenge](i)
+		if err != nil {
+			return nil, err
+		}
+
+		acceptLogout, err := do.Invoke[*usecase.AcceptLogout](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rejectLogout, err := do.Invoke[*usecase.RejectLogout](i)
+		if err != nil {
+			return nil, err
+		}
+
+		getBotPhoto, err := do.Invoke[*usecase.GetBotPhoto](i)
+		if err != nil {
+			return nil, err
+		}
+
+		var baseUri *url.URL
+		if cfg.HTTPServer.BaseUri != (config.URL{}) {
+			baseUri = cfg.HTTPServer.BaseUri.URL()
+		} else {
+			uri, err := buildBaseURL(cfg.HTTPServer.Address)
+			if err != nil {
+				return nil, err
+			}
+			baseUri = uri
+		}
+
 		errorUri := *baseUri
 		errorUri = *errorUri.JoinPath("/error")
-		webServer := webhttp.NewServer(&errorUri, resolveLoginChallenge)
+		webServer := webhttp.NewServer(
+			&errorUri,
+			resolveLoginChallenge,
+			loginByLoginUrl,
+			resolveConsentChallenge,
+			acceptConsent,
+			rejectConsent,
+			resolveLogoutChallenge,
+			acceptLogout,
+			rejectLogout,
+			getBotPhoto,
+		)
 
 		webRenderer, err := webhttp.NewRenderer()
 		if err != nil {
@@ -67,20 +302,184 @@ func provideEchoApp(injector do.Injector) {
 		echoApp.HidePort = shouldHideEchoBanner(cfg)
 		echoApp.Renderer = webRenderer
 
+		ipExtractor, err := buildIPExtractor(&cfg.HTTPServer.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+		echoApp.IPExtractor = ipExtractor
+		echoApp.Use(clientip.Middleware())
+
 		webServer.Register(echoApp)
 
-		// Add request/response validation middleware for API endpoints
-		spec, err := generated.GetSwagger()
+		// The private API moves to the admin listener when it is configured
+		routes := allAPIRoutes
+		if cfg.AdminServer != nil {
+			routes = publicAPIRoutes
+		}
+		if err := registerAPIHandlers(i, echoApp, routes); err != nil {
+			return nil, err
+		}
+
+		return echoApp, nil
+	})
+
+	do.ProvideNamed(injector, AdminEchoApp, func(i do.Injector) (*echo.Echo, error) {
+		cfg, err := do.Invoke[*config.Config](i)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get OpenAPI spec: %w", err)
+			return nil, err
+		}
+		if cfg.AdminServer == nil {
+			return nil, errors.New("admin server is not configured")
 		}
-		apiGroup := echoApp.Group("")
-		apiGroup.Use(echo_middleware.OapiRequestValidator(spec))
 
-		generated.RegisterHandlers(apiGroup, generated.NewStrictHandler(apiServer, nil))
+		echoApp := echo.New()
+		echoApp.HideBanner = true
+		echoApp.HidePort = shouldHideEchoBanner(cfg)
+
+		ipExtractor, err := buildIPExtractor(&cfg.HTTPServer.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+		echoApp.IPExtractor = ipExtractor
+		echoApp.Use(clientip.Middleware())
+
+		if err := registerAPIHandlers(i, echoApp, privateAPIRoutes); err != nil {
+			return nil, err
+		}
 
 		return echoApp, nil
 	})
+
+	do.ProvideNamed(injector, AdminListener, func(i do.Injector) (net.Listener, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.AdminServer == nil {
+			return nil, errors.New("admin server is not configured")
+		}
+
+		return buildAdminListener(cfg.AdminServer)
+	})
+}
+
+// registerAPIHandlers registers the selected API operations together with request validation
+// and private API authentication.
+func registerAPIHandlers(i do.Injector, echoApp *echo.Echo, routes apiRoutes) error {
+	apiServer, err := do.Invoke[generated.StrictServerInterface](i)
+	if err != nil {
+		return err
+	}
+
+	apiKeyAuthenticator, err := do.Invoke[*adminauth.APIKeyAuthenticator](i)
+	if err != nil {
+		return err
+	}
+
+	hmacAuthenticator, err := do.Invoke[*adminauth.HMACAuthenticator](i)
+	if err != nil {
+		return err
+	}
+
+	spec, err := generated.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to get OpenAPI spec: %w", err)
+	}
+	authenticators := map[string]service.AdminAuthenticator{
+		apihttp.AdminApiKeySecurityScheme:    apiKeyAuthenticator,
+		apihttp.AdminSignatureSecurityScheme: hmacAuthenticator,
+	}
+
+	apiGroup := echoApp.Group("")
+	apiGroup.Use(echo_middleware.OapiRequestValidatorWithOptions(spec, &echo_middleware.Options{
+		ErrorHandler: apihttp.ValidationErrorHandler,
+		Options: openapi3filter.Options{
+			AuthenticationFunc: apihttp.NewAuthenticationFunc(authenticators),
+		},
+	}))
+
+	var router generated.EchoRouter = apiGroup
+	switch routes {
+	case publicAPIRoutes:
+		router = apihttp.NewTaggedRouter(apiGroup, spec, apihttp.PrivateTag, false)
+	case privateAPIRoutes:
+		router = apihttp.NewTaggedRouter(apiGroup, spec, apihttp.PrivateTag, true)
+	}
+	generated.RegisterHandlers(router, generated.NewStrictHandler(apiServer, nil))
+	return nil
+}
+
+// buildIPExtractor resolves client addresses from the configured header of trusted proxies.
+func buildIPExtractor(cfg *config.TrustedProxiesConfig) (echo.IPExtractor, error) {
+	trustedProxies := make([]netip.Prefix, 0, len(cfg.CIDRs))
+	for _, cidr := range cfg.CIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		trustedProxies = append(trustedProxies, prefix)
+	}
+
+	return clientip.NewExtractor(trustedProxies, clientip.Header(cfg.Header))
+}
+
+// buildAdminListener listens on the unix socket or TCP address of the admin server,
+// wrapping the listener into TLS, with client certificate verification when a client CA is set.
+func buildAdminListener(cfg *config.AdminServerConfig) (net.Listener, error) {
+	var (
+		listener net.Listener
+		err      error
+	)
+	if cfg.UnixSocket != "" {
+		// Remove a socket left behind by a previous run
+		if err := os.Remove(cfg.UnixSocket); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove stale admin socket: %w", err)
+		}
+		listener, err = net.Listen("unix", cfg.UnixSocket)
+	} else {
+		listener, err = net.Listen("tcp", cfg.Address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen admin server: %w", err)
+	}
+
+	if cfg.TLS == nil {
+		return listener, nil
+	}
+
+	tlsConfig, err := buildAdminTLSConfig(cfg.TLS)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	return tls.NewListener(listener, tlsConfig), nil
+}
+
+func buildAdminTLSConfig(cfg *config.AdminServerTLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read admin client CA: %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("admin client CA file contains no certificates")
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
 }
 
 func shouldHideEchoBanner(cfg *config.Config) bool {
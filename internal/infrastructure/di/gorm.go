@@ -43,8 +43,11 @@ func provideGorm(injector do.Injector) {
 		}
 
 		// Use gorm-zerolog which reads logger from context via zerolog.Ctx()
+		// TranslateError maps unique and foreign key violations to gorm.ErrDuplicatedKey and
+		// gorm.ErrForeignKeyViolated, which the repositories match instead of driver errors
 		db, err := gorm.Open(dialector, &gorm.Config{
-			Logger: gormzerolog.Logger{},
+			Logger:         gormzerolog.Logger{},
+			TranslateError: true,
 		})
 		if err != nil {
 			return nil, err
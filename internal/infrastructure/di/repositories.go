@@ -1,11 +1,15 @@
 package di
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/samber/do/v2"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
 	"github.com/ulbwa/telegram-oidc-provider/internal/domain/repository"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/config"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/db/postgres"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/keyring"
 	"gorm.io/gorm"
 )
 
@@ -28,17 +32,116 @@ func provideRepositories(injector do.Injector) {
 		return postgres.NewBotUserRepository(db), nil
 	})
 
+	do.Provide(injector, func(i do.Injector) (*keyring.Keyring, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildBotTokenKeyring(context.Background(), &cfg.Security.BotToken)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*postgres.GormBotRepository, error) {
+		db, err := do.Invoke[*gorm.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenKeyring, err := do.Invoke[*keyring.Keyring](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return postgres.NewBotRepository(db, tokenKeyring)
+	})
+
 	do.Provide(injector, func(i do.Injector) (repository.BotRepositoryPort, error) {
+		return do.Invoke[*postgres.GormBotRepository](i)
+	})
+
+	do.Provide(injector, func(i do.Injector) (repository.BotClaimMappingRepositoryPort, error) {
 		db, err := do.Invoke[*gorm.DB](i)
 		if err != nil {
 			return nil, err
 		}
 
-		cfg, err := do.Invoke[*config.Config](i)
+		return postgres.NewBotClaimMappingRepository(db), nil
+	})
+
+	do.Provide(injector, func(i do.Injector) (repository.LoginEventRepositoryPort, error) {
+		db, err := do.Invoke[*gorm.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return postgres.NewLoginEventRepository(db), nil
+	})
+
+	do.Provide(injector, func(i do.Injector) (repository.BotAccessPolicyRepositoryPort, error) {
+		db, err := do.Invoke[*gorm.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return postgres.NewBotAccessPolicyRepository(db), nil
+	})
+
+	do.Provide(injector, func(i do.Injector) (repository.BotChatRepositoryPort, error) {
+		db, err := do.Invoke[*gorm.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return postgres.NewBotChatRepository(db), nil
+	})
+
+	do.Provide(injector, func(i do.Injector) (repository.BotPhotoRepositoryPort, error) {
+		db, err := do.Invoke[*gorm.DB](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return postgres.NewBotRepository(db, []byte(cfg.Security.BotToken.EncryptionKey))
+		return postgres.NewBotPhotoRepository(db), nil
 	})
 }
+
+// buildBotTokenKeyProvider selects the provider of a bot token encryption key.
+func buildBotTokenKeyProvider(cfg *config.BotTokenKeySourceConfig) keyring.KeyProvider {
+	switch cfg.Source {
+	case "file":
+		return keyring.NewFileKeyProvider(cfg.Path)
+	case "env":
+		return keyring.NewEnvKeyProvider(cfg.Env)
+	case "envelope":
+		return keyring.NewEnvelopeKeyProvider(cfg.WrappedKey, cfg.MasterKeyFile)
+	default:
+		return keyring.NewInlineKeyProvider(cfg.Key)
+	}
+}
+
+// buildBotTokenKeyring loads the configured keys and creates the keyring used to encrypt bot tokens.
+func buildBotTokenKeyring(ctx context.Context, cfg *config.SecurityBotTokenConfig) (*keyring.Keyring, error) {
+	keys := make([]keyring.Key, 0, len(cfg.Keys))
+	for i := range cfg.Keys {
+		keyCfg := &cfg.Keys[i]
+		secret, err := buildBotTokenKeyProvider(&keyCfg.BotTokenKeySourceConfig).Key(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("bot token key %q: %w", keyCfg.Id, err)
+		}
+		keys = append(keys, keyring.Key{Id: keyCfg.Id, Secret: secret})
+	}
+
+	var legacyKey []byte
+	switch {
+	case cfg.LegacyKey != nil:
+		secret, err := buildBotTokenKeyProvider(cfg.LegacyKey).Key(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("legacy bot token key: %w", err)
+		}
+		legacyKey = secret
+	case cfg.EncryptionKey != "":
+		legacyKey = []byte(cfg.EncryptionKey)
+	}
+
+	return keyring.New(keys, cfg.ActiveKeyId, legacyKey)
+}
@@ -1,9 +1,14 @@
 package di
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
 	"github.com/redis/go-redis/v9"
 	"github.com/samber/do/v2"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/adminauth"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/cache"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/config"
 	"github.com/ulbwa/telegram-oidc-provider/internal/infrastructure/telegram"
@@ -39,18 +44,55 @@ func provideServices(injector do.Injector) {
 		return telegram.NewTelegramTokenVerifier(tokenCache), nil
 	})
 
+	do.Provide(injector, func(i do.Injector) (service.TelegramChatMemberCache, error) {
+		redisClient, err := do.Invoke[*redis.Client](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheCfg := cfg.Security.Telegram.ChatMemberCache
+		return cache.NewRedisChatMemberCache(redisClient, cacheCfg.Prefix, cacheCfg.TTL)
+	})
+
+	do.Provide(injector, func(i do.Injector) (service.TelegramChatMemberChecker, error) {
+		memberCache, err := do.Invoke[service.TelegramChatMemberCache](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return telegram.NewTelegramChatMemberChecker(memberCache), nil
+	})
+
 	do.Provide(injector, func(i do.Injector) (service.TelegramWidgetDataParser, error) {
 		return telegram.NewTelegramWidgetDataParser(), nil
 	})
 
 	do.Provide(injector, func(i do.Injector) (service.TelegramMiniAppDataParser, error) {
-		return telegram.NewTelegramWidgetDataParser(), nil
+		return telegram.NewTelegramMiniAppDataParser(), nil
 	})
 
 	do.Provide(injector, func(i do.Injector) (service.TelegramAuthHashVerifier, error) {
 		return telegram.NewTelegramAuthHashVerifier(), nil
 	})
 
+	do.Provide(injector, func(i do.Injector) (service.TelegramMiniAppAuthHashVerifier, error) {
+		return telegram.NewTelegramMiniAppAuthHashVerifier(), nil
+	})
+
+	do.Provide(injector, func(i do.Injector) (service.TelegramMiniAppSignatureVerifier, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return telegram.NewTelegramMiniAppSignatureVerifier(cfg.Security.Telegram.MiniAppSignature.PublicKey)
+	})
+
 	do.Provide(injector, func(i do.Injector) (service.TelegramReplayGuard, error) {
 		redisClient, err := do.Invoke[*redis.Client](i)
 		if err != nil {
@@ -65,4 +107,101 @@ func provideServices(injector do.Injector) {
 		replayCfg := cfg.Security.Telegram.ReplayGuard
 		return telegram.NewRedisTelegramReplayGuard(redisClient, replayCfg.Prefix)
 	})
+
+	do.Provide(injector, func(i do.Injector) (service.TelegramLoginNonceStore, error) {
+		redisClient, err := do.Invoke[*redis.Client](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return cache.NewRedisLoginNonceStore(redisClient, cfg.Security.Telegram.BotLogin.Prefix)
+	})
+
+	do.Provide(injector, func(i do.Injector) (service.TelegramBotWebhook, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		secret := cfg.Security.Telegram.BotLogin.WebhookSecret
+		if secret == "" {
+			// Bot webhooks are not in use: a random secret makes the provider reject every update
+			buf := make([]byte, 32)
+			if _, err := rand.Read(buf); err != nil {
+				return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+			}
+			secret = hex.EncodeToString(buf)
+		}
+
+		return telegram.NewTelegramBotWebhook(secret)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*adminauth.APIKeyAuthenticator, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]adminauth.APIKey, 0, len(cfg.Security.Admin.APIKeys))
+		for _, key := range cfg.Security.Admin.APIKeys {
+			keys = append(keys, adminauth.APIKey{Id: key.Id, Hash: key.Hash, Scopes: key.Scopes})
+		}
+		return adminauth.NewAPIKeyAuthenticator(keys)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*adminauth.HMACAuthenticator, error) {
+		redisClient, err := do.Invoke[*redis.Client](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		nonceGuard, err := telegram.NewRedisTelegramReplayGuard(redisClient, cfg.Security.Admin.NoncePrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]adminauth.HMACKey, 0, len(cfg.Security.Admin.HMACKeys))
+		for _, key := range cfg.Security.Admin.HMACKeys {
+			keys = append(keys, adminauth.HMACKey{Id: key.Id, Secret: key.Secret, Scopes: key.Scopes})
+		}
+		return adminauth.NewHMACAuthenticator(keys, cfg.Security.Admin.SignatureMaxSkew, nonceGuard)
+	})
+
+	do.Provide(injector, func(i do.Injector) (service.RateLimiter, error) {
+		redisClient, err := do.Invoke[*redis.Client](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return cache.NewRedisRateLimiter(redisClient, cfg.Security.RateLimit.Prefix)
+	})
+
+	do.Provide(injector, func(i do.Injector) (service.JobLock, error) {
+		redisClient, err := do.Invoke[*redis.Client](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return cache.NewRedisJobLock(redisClient, cfg.Jobs.LockPrefix)
+	})
 }
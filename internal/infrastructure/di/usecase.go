@@ -13,6 +13,11 @@ import (
 
 func provideUsecases(injector do.Injector) {
 	do.Provide(injector, func(i do.Injector) (*usecase.SyncBot, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
 		transactor, err := do.Invoke[service.Transactor](i)
 		if err != nil {
 			return nil, err
@@ -23,12 +28,48 @@ func provideUsecases(injector do.Injector) {
 			return nil, err
 		}
 
+		botPhotoRepo, err := do.Invoke[repository.BotPhotoRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
 		botVerifier, err := do.Invoke[service.TelegramTokenVerifier](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return usecase.NewSyncBot(transactor, botRepo, botVerifier)
+		botWebhook, err := do.Invoke[service.TelegramBotWebhook](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rateLimiter, err := do.Invoke[service.RateLimiter](i)
+		if err != nil {
+			return nil, err
+		}
+
+		var baseUri *url.URL
+		if cfg.HTTPServer.BaseUri != (config.URL{}) {
+			baseUri = cfg.HTTPServer.BaseUri.URL()
+		} else {
+			uri, err := buildBaseURL(cfg.HTTPServer.Address)
+			if err != nil {
+				return nil, err
+			}
+			baseUri = uri
+		}
+
+		return usecase.NewSyncBot(
+			transactor,
+			botRepo,
+			botPhotoRepo,
+			botVerifier,
+			botWebhook,
+			baseUri,
+			cfg.Security.Telegram.BotLogin.RegisterWebhook,
+			rateLimiter,
+			buildRateLimits(&cfg.Security.RateLimit.CreateBot),
+		)
 	})
 
 	do.Provide(injector, func(i do.Injector) (*usecase.ResolveLoginChallenge, error) {
@@ -52,11 +93,36 @@ func provideUsecases(injector do.Injector) {
 			return nil, err
 		}
 
+		accessPolicyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
 		tokenVerifier, err := do.Invoke[service.TelegramTokenVerifier](i)
 		if err != nil {
 			return nil, err
 		}
 
+		nonceStore, err := do.Invoke[service.TelegramLoginNonceStore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
 		var baseUri *url.URL
 		if cfg.HTTPServer.BaseUri != (config.URL{}) {
 			baseUri = cfg.HTTPServer.BaseUri.URL()
@@ -71,10 +137,18 @@ func provideUsecases(injector do.Injector) {
 		return usecase.NewResolveLoginChallenge(
 			baseUri,
 			cfg.HTTPServer.TelegramAuthURI.URL(),
+			cfg.HTTPServer.TelegramDeepLinkURI.URL(),
 			hydraClient,
 			botRepo,
 			botUserRepo,
+			accessPolicyRepo,
+			chatRepo,
+			chatMemberChecker,
 			tokenVerifier,
+			nonceStore,
+			loginEventRepo,
+			cfg.Security.Telegram.BotLogin.TTL,
+			cfg.Security.Telegram.BotLogin.Enabled,
 		)
 	})
 
@@ -124,6 +198,31 @@ func provideUsecases(injector do.Injector) {
 			return nil, err
 		}
 
+		accessPolicyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rateLimiter, err := do.Invoke[service.RateLimiter](i)
+		if err != nil {
+			return nil, err
+		}
+
 		return usecase.NewLoginByWidget(
 			transactor,
 			hydraClient,
@@ -133,7 +232,741 @@ func provideUsecases(injector do.Injector) {
 			replayGuard,
 			botRepo,
 			botUserRepo,
+			accessPolicyRepo,
+			chatRepo,
+			chatMemberChecker,
+			loginEventRepo,
+			cfg.Security.Telegram.AuthDataTTLSeconds,
+			rateLimiter,
+			buildRateLimits(&cfg.Security.RateLimit.WidgetCallback),
+		)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.LoginByMiniApp, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		miniAppDataParser, err := do.Invoke[service.TelegramMiniAppDataParser](i)
+		if err != nil {
+			return nil, err
+		}
+
+		authHashVerifier, err := do.Invoke[service.TelegramMiniAppAuthHashVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		signatureVerifier, err := do.Invoke[service.TelegramMiniAppSignatureVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenVerifier, err := do.Invoke[service.TelegramTokenVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		replayGuard, err := do.Invoke[service.TelegramReplayGuard](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		accessPolicyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rateLimiter, err := do.Invoke[service.RateLimiter](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewLoginByMiniApp(
+			transactor,
+			hydraClient,
+			miniAppDataParser,
+			authHashVerifier,
+			signatureVerifier,
+			tokenVerifier,
+			replayGuard,
+			botRepo,
+			botUserRepo,
+			accessPolicyRepo,
+			chatRepo,
+			chatMemberChecker,
+			loginEventRepo,
 			cfg.Security.Telegram.AuthDataTTLSeconds,
+			rateLimiter,
+			buildRateLimits(&cfg.Security.RateLimit.MiniAppCallback),
+		)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.LoginByBot, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		nonceStore, err := do.Invoke[service.TelegramLoginNonceStore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		accessPolicyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewLoginByBot(
+			transactor,
+			hydraClient,
+			nonceStore,
+			botRepo,
+			botUserRepo,
+			accessPolicyRepo,
+			chatRepo,
+			chatMemberChecker,
+			loginEventRepo,
 		)
 	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ConfirmLoginByBot, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botWebhook, err := do.Invoke[service.TelegramBotWebhook](i)
+		if err != nil {
+			return nil, err
+		}
+
+		nonceStore, err := do.Invoke[service.TelegramLoginNonceStore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewConfirmLoginByBot(hydraClient, botRepo, botWebhook, nonceStore)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.LoginByLoginUrl, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		widgetDataParser, err := do.Invoke[service.TelegramWidgetDataParser](i)
+		if err != nil {
+			return nil, err
+		}
+
+		authHashVerifier, err := do.Invoke[service.TelegramAuthHashVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenVerifier, err := do.Invoke[service.TelegramTokenVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		replayGuard, err := do.Invoke[service.TelegramReplayGuard](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		accessPolicyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginHintStore, err := do.Invoke[service.TelegramLoginNonceStore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewLoginByLoginUrl(
+			cfg.Hydra.PublicURL.URL(),
+			transactor,
+			hydraClient,
+			widgetDataParser,
+			authHashVerifier,
+			tokenVerifier,
+			replayGuard,
+			botRepo,
+			botUserRepo,
+			accessPolicyRepo,
+			chatRepo,
+			chatMemberChecker,
+			loginEventRepo,
+			loginHintStore,
+			cfg.Security.Telegram.AuthDataTTLSeconds,
+			cfg.Security.Telegram.BotLogin.TTL,
+		)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ResolveConsentChallenge, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingRepo, err := do.Invoke[repository.BotClaimMappingRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewResolveConsentChallenge(hydraClient, botRepo, botUserRepo, mappingRepo, chatRepo, chatMemberChecker)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.AcceptConsent, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingRepo, err := do.Invoke[repository.BotClaimMappingRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatMemberChecker, err := do.Invoke[service.TelegramChatMemberChecker](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewAcceptConsent(hydraClient, botRepo, botUserRepo, mappingRepo, chatRepo, chatMemberChecker)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.RejectConsent, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewRejectConsent(hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBotClaimMappings, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingRepo, err := do.Invoke[repository.BotClaimMappingRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewGetBotClaimMappings(botRepo, mappingRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.SetBotClaimMappings, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		mappingRepo, err := do.Invoke[repository.BotClaimMappingRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewSetBotClaimMappings(transactor, botRepo, mappingRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ResolveLogoutChallenge, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewResolveLogoutChallenge(hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.AcceptLogout, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewAcceptLogout(hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.RejectLogout, error) {
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewRejectLogout(hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.LinkBotToClient, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewLinkBotToClient(transactor, botRepo, hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.UnlinkBotFromClient, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewUnlinkBotFromClient(transactor, botRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.CreateBotClient, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewCreateBotClient(transactor, botRepo, hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBot, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		var baseUri *url.URL
+		if cfg.HTTPServer.BaseUri != (config.URL{}) {
+			baseUri = cfg.HTTPServer.BaseUri.URL()
+		} else {
+			uri, err := buildBaseURL(cfg.HTTPServer.Address)
+			if err != nil {
+				return nil, err
+			}
+			baseUri = uri
+		}
+
+		return usecase.NewGetBot(botRepo, baseUri, cfg.HTTPServer.TelegramDeepLinkURI.URL())
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBotPhoto, error) {
+		botPhotoRepo, err := do.Invoke[repository.BotPhotoRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewGetBotPhoto(botPhotoRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ListBots, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewListBots(botRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.DeleteBot, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewDeleteBot(botRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ListBotUsers, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewListBotUsers(botRepo, botUserRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBotUser, error) {
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewGetBotUser(botUserRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.DeleteBotUser, error) {
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewDeleteBotUser(botUserRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ExportUserData, error) {
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewExportUserData(botUserRepo, loginEventRepo, hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.EraseUserData, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botUserRepo, err := do.Invoke[repository.BotUserRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		hydraClient, err := do.Invoke[*hydra.APIClient](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewEraseUserData(transactor, botUserRepo, loginEventRepo, hydraClient)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.ListLoginEvents, error) {
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewListLoginEvents(loginEventRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBotAccessPolicy, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		policyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewGetBotAccessPolicy(botRepo, policyRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.SetBotAccessPolicy, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		policyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewSetBotAccessPolicy(transactor, botRepo, policyRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.DeleteBotAccessPolicy, error) {
+		policyRepo, err := do.Invoke[repository.BotAccessPolicyRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewDeleteBotAccessPolicy(policyRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.GetBotChats, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewGetBotChats(botRepo, chatRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.SetBotChats, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		chatRepo, err := do.Invoke[repository.BotChatRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewSetBotChats(transactor, botRepo, chatRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.SetBotStatus, error) {
+		transactor, err := do.Invoke[service.Transactor](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewSetBotStatus(transactor, botRepo)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.VerifyBotCredentials, error) {
+		botRepo, err := do.Invoke[repository.BotRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		botVerifier, err := do.Invoke[service.TelegramTokenVerifier](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewVerifyBotCredentials(botRepo, botVerifier)
+	})
+
+	do.Provide(injector, func(i do.Injector) (*usecase.PruneLoginEvents, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		loginEventRepo, err := do.Invoke[repository.LoginEventRepositoryPort](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.NewPruneLoginEvents(loginEventRepo, cfg.Jobs.LoginEvents.Retention)
+	})
+}
+
+// buildRateLimits converts the configured limits of a route.
+func buildRateLimits(cfg *config.RateLimitRouteConfig) usecase.RateLimits {
+	toRateLimit := func(rule *config.RateLimitRuleConfig) *service.RateLimit {
+		if rule == nil {
+			return nil
+		}
+		return &service.RateLimit{Limit: rule.Limit, Window: rule.Window}
+	}
+
+	return usecase.RateLimits{
+		IP:   toRateLimit(cfg.IP),
+		Bot:  toRateLimit(cfg.Bot),
+		User: toRateLimit(cfg.User),
+	}
 }
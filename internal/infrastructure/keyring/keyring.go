@@ -0,0 +1,214 @@
+package keyring
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the size of AES-256 keys.
+const KeySize = 32
+
+// maxKeyIdLength keeps the ciphertext prefix short.
+const maxKeyIdLength = 32
+
+// ciphertextPrefix marks ciphertexts produced by a keyring. The full format is
+// "k1:<key id>:" || nonce || AES-256-GCM sealed data, with the key id as additional data.
+// Ciphertexts without the prefix are legacy ones, encrypted with the single key used before keyrings.
+var ciphertextPrefix = []byte("k1:")
+
+var (
+	// ErrInvalidKey is returned when a key or key id is malformed
+	ErrInvalidKey = errors.New("invalid encryption key")
+
+	// ErrUnknownKey is returned when a ciphertext was encrypted with a key missing from the keyring
+	ErrUnknownKey = errors.New("unknown encryption key")
+
+	// ErrDecryptionFailed is returned when a ciphertext cannot be decrypted
+	ErrDecryptionFailed = errors.New("decryption failed")
+)
+
+// Key is an AES-256 key identified by a key id.
+type Key struct {
+	Id     string
+	Secret []byte
+}
+
+// Keyring encrypts data with the active key and decrypts data encrypted with any known key.
+type Keyring struct {
+	keys        map[string]cipher.AEAD
+	activeKeyId string
+	legacy      cipher.AEAD // Decrypts unprefixed ciphertexts, encrypts when there is no active key
+}
+
+// New creates a keyring. The active key id must refer to one of the keys, unless no keys are given,
+// in which case the legacy key is used for encryption without a key id prefix.
+func New(keys []Key, activeKeyId string, legacyKey []byte) (*Keyring, error) {
+	keyring := &Keyring{
+		keys:        make(map[string]cipher.AEAD, len(keys)),
+		activeKeyId: activeKeyId,
+	}
+
+	for _, key := range keys {
+		if err := validateKeyId(key.Id); err != nil {
+			return nil, err
+		}
+		if _, ok := keyring.keys[key.Id]; ok {
+			return nil, fmt.Errorf("%w: duplicate key id %q", ErrInvalidKey, key.Id)
+		}
+		aead, err := newAEAD(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", key.Id, err)
+		}
+		keyring.keys[key.Id] = aead
+	}
+
+	if legacyKey != nil {
+		aead, err := newAEAD(legacyKey)
+		if err != nil {
+			return nil, fmt.Errorf("legacy key: %w", err)
+		}
+		keyring.legacy = aead
+	}
+
+	if len(keyring.keys) == 0 {
+		if keyring.legacy == nil {
+			return nil, fmt.Errorf("%w: no keys configured", ErrInvalidKey)
+		}
+		if activeKeyId != "" {
+			return nil, fmt.Errorf("%w: active key %q is not configured", ErrInvalidKey, activeKeyId)
+		}
+		return keyring, nil
+	}
+
+	if _, ok := keyring.keys[activeKeyId]; !ok {
+		return nil, fmt.Errorf("%w: active key %q is not configured", ErrInvalidKey, activeKeyId)
+	}
+
+	return keyring, nil
+}
+
+func validateKeyId(id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: key id cannot be empty", ErrInvalidKey)
+	}
+	if len(id) > maxKeyIdLength {
+		return fmt.Errorf("%w: key id %q is too long", ErrInvalidKey, id)
+	}
+	for _, r := range id {
+		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-' || r == '.') {
+			return fmt.Errorf("%w: key id %q contains invalid characters", ErrInvalidKey, id)
+		}
+	}
+	return nil
+}
+
+func newAEAD(secret []byte) (cipher.AEAD, error) {
+	if len(secret) != KeySize {
+		return nil, fmt.Errorf("%w: key must be %d bytes for AES-256", ErrInvalidKey, KeySize)
+	}
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create cipher: %v", ErrInvalidKey, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create GCM: %v", ErrInvalidKey, err)
+	}
+	return aead, nil
+}
+
+// ActiveKeyId returns the id of the key used for encryption, empty when the legacy key is used.
+func (k *Keyring) ActiveKeyId() string {
+	return k.activeKeyId
+}
+
+// Encrypt encrypts the plaintext with the active key.
+func (k *Keyring) Encrypt(plaintext []byte) ([]byte, error) {
+	if k.activeKeyId == "" {
+		return seal(k.legacy, nil, plaintext, nil)
+	}
+
+	header := make([]byte, 0, len(ciphertextPrefix)+len(k.activeKeyId)+1)
+	header = append(header, ciphertextPrefix...)
+	header = append(header, k.activeKeyId...)
+	header = append(header, ':')
+	return seal(k.keys[k.activeKeyId], header, plaintext, []byte(k.activeKeyId))
+}
+
+func seal(aead cipher.AEAD, header, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(header)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, header...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, additionalData), nil
+}
+
+// parseKeyId returns the key id and the remaining payload of a prefixed ciphertext.
+func parseKeyId(ciphertext []byte) (string, []byte, bool) {
+	rest, ok := bytes.CutPrefix(ciphertext, ciphertextPrefix)
+	if !ok {
+		return "", nil, false
+	}
+	id, payload, ok := bytes.Cut(rest, []byte(":"))
+	if !ok || validateKeyId(string(id)) != nil {
+		return "", nil, false
+	}
+	return string(id), payload, true
+}
+
+// Decrypt decrypts a ciphertext produced by any key of the keyring, or by the legacy key.
+func (k *Keyring) Decrypt(ciphertext []byte) ([]byte, error) {
+	if id, payload, ok := parseKeyId(ciphertext); ok {
+		aead, known := k.keys[id]
+		if known {
+			if plaintext, err := open(aead, payload, []byte(id)); err == nil {
+				return plaintext, nil
+			}
+		}
+		// A legacy nonce may start with the prefix by chance
+		if k.legacy == nil {
+			if !known {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+			}
+			return nil, ErrDecryptionFailed
+		}
+	}
+
+	if k.legacy == nil {
+		return nil, fmt.Errorf("%w: ciphertext has no key id", ErrUnknownKey)
+	}
+	return open(k.legacy, ciphertext, nil)
+}
+
+func open(aead cipher.AEAD, payload, additionalData []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(payload) < nonceSize {
+		return nil, fmt.Errorf("%w: ciphertext too short", ErrDecryptionFailed)
+	}
+
+	nonce, sealed := payload[:nonceSize], payload[nonceSize:]
+	plaintext, err := aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+	return plaintext, nil
+}
+
+// IsActive reports whether the ciphertext is encrypted with the active key,
+// so that re-encryption can skip it.
+func (k *Keyring) IsActive(ciphertext []byte) bool {
+	id, _, ok := parseKeyId(ciphertext)
+	if k.activeKeyId == "" {
+		return !ok
+	}
+	return ok && id == k.activeKeyId
+}
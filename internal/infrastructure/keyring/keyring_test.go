@@ -0,0 +1,225 @@
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func testSecret(b byte) []byte {
+	return bytes.Repeat([]byte{b}, KeySize)
+}
+
+func mustKeyring(t *testing.T, keys []Key, activeKeyId string, legacyKey []byte) *Keyring {
+	t.Helper()
+	keyring, err := New(keys, activeKeyId, legacyKey)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return keyring
+}
+
+func mustEncrypt(t *testing.T, keyring *Keyring, plaintext string) []byte {
+	t.Helper()
+	ciphertext, err := keyring.Encrypt([]byte(plaintext))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	return ciphertext
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []Key
+		activeKeyId string
+		legacyKey   []byte
+		wantErr     error
+	}{
+		{
+			name:        "active key",
+			keys:        []Key{{Id: "a", Secret: testSecret(1)}},
+			activeKeyId: "a",
+		},
+		{
+			name:      "legacy key only",
+			legacyKey: testSecret(1),
+		},
+		{
+			name:    "no keys",
+			wantErr: ErrInvalidKey,
+		},
+		{
+			name:        "active key not configured",
+			keys:        []Key{{Id: "a", Secret: testSecret(1)}},
+			activeKeyId: "b",
+			wantErr:     ErrInvalidKey,
+		},
+		{
+			name:        "duplicate key id",
+			keys:        []Key{{Id: "a", Secret: testSecret(1)}, {Id: "a", Secret: testSecret(2)}},
+			activeKeyId: "a",
+			wantErr:     ErrInvalidKey,
+		},
+		{
+			name:        "invalid key id",
+			keys:        []Key{{Id: "a:b", Secret: testSecret(1)}},
+			activeKeyId: "a:b",
+			wantErr:     ErrInvalidKey,
+		},
+		{
+			name:        "short key",
+			keys:        []Key{{Id: "a", Secret: []byte("short")}},
+			activeKeyId: "a",
+			wantErr:     ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.keys, tt.activeKeyId, tt.legacyKey)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyringDecrypt(t *testing.T) {
+	keyA := Key{Id: "a", Secret: testSecret(1)}
+	keyB := Key{Id: "b", Secret: testSecret(2)}
+	legacyKey := testSecret(3)
+
+	legacy := mustKeyring(t, nil, "", legacyKey)
+	activeA := mustKeyring(t, []Key{keyA, keyB}, "a", nil)
+	activeB := mustKeyring(t, []Key{keyA, keyB}, "b", nil)
+
+	tamperedKeyId := bytes.Replace(mustEncrypt(t, activeA, "token"), []byte("k1:a:"), []byte("k1:b:"), 1)
+	tamperedPayload := mustEncrypt(t, activeA, "token")
+	tamperedPayload[len(tamperedPayload)-1] ^= 0xff
+
+	tests := []struct {
+		name       string
+		keyring    *Keyring
+		ciphertext []byte
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "round trip with the active key",
+			keyring:    activeA,
+			ciphertext: mustEncrypt(t, activeA, "token"),
+			want:       "token",
+		},
+		{
+			name:       "non-active key",
+			keyring:    activeB,
+			ciphertext: mustEncrypt(t, activeA, "token"),
+			want:       "token",
+		},
+		{
+			name:       "legacy unprefixed ciphertext",
+			keyring:    mustKeyring(t, []Key{keyA}, "a", legacyKey),
+			ciphertext: mustEncrypt(t, legacy, "token"),
+			want:       "token",
+		},
+		{
+			name:       "legacy ciphertext without legacy key",
+			keyring:    activeA,
+			ciphertext: mustEncrypt(t, legacy, "token"),
+			wantErr:    ErrUnknownKey,
+		},
+		{
+			name:       "unknown key id",
+			keyring:    mustKeyring(t, []Key{keyB}, "b", nil),
+			ciphertext: mustEncrypt(t, activeA, "token"),
+			wantErr:    ErrUnknownKey,
+		},
+		{
+			name:       "tampered key id",
+			keyring:    activeA,
+			ciphertext: tamperedKeyId,
+			wantErr:    ErrDecryptionFailed,
+		},
+		{
+			name:       "tampered payload",
+			keyring:    activeA,
+			ciphertext: tamperedPayload,
+			wantErr:    ErrDecryptionFailed,
+		},
+		{
+			name:       "truncated ciphertext",
+			keyring:    activeA,
+			ciphertext: []byte("k1:a:short"),
+			wantErr:    ErrDecryptionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plaintext, err := tt.keyring.Decrypt(tt.ciphertext)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decrypt() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && string(plaintext) != tt.want {
+				t.Fatalf("Decrypt() = %q, want %q", plaintext, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyringIsActive(t *testing.T) {
+	keyA := Key{Id: "a", Secret: testSecret(1)}
+	keyB := Key{Id: "b", Secret: testSecret(2)}
+	legacyKey := testSecret(3)
+
+	legacy := mustKeyring(t, nil, "", legacyKey)
+	activeA := mustKeyring(t, []Key{keyA, keyB}, "a", legacyKey)
+	activeB := mustKeyring(t, []Key{keyA, keyB}, "b", legacyKey)
+
+	tests := []struct {
+		name       string
+		keyring    *Keyring
+		ciphertext []byte
+		want       bool
+	}{
+		{
+			name:       "active key",
+			keyring:    activeA,
+			ciphertext: mustEncrypt(t, activeA, "token"),
+			want:       true,
+		},
+		{
+			name:       "non-active key",
+			keyring:    activeA,
+			ciphertext: mustEncrypt(t, activeB, "token"),
+			want:       false,
+		},
+		{
+			name:       "legacy ciphertext with active key",
+			keyring:    activeA,
+			ciphertext: mustEncrypt(t, legacy, "token"),
+			want:       false,
+		},
+		{
+			name:       "legacy ciphertext without active key",
+			keyring:    legacy,
+			ciphertext: mustEncrypt(t, legacy, "token"),
+			want:       true,
+		},
+		{
+			name:       "prefixed ciphertext without active key",
+			keyring:    legacy,
+			ciphertext: mustEncrypt(t, activeA, "token"),
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.keyring.IsActive(tt.ciphertext); got != tt.want {
+				t.Fatalf("IsActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
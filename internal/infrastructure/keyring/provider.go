@@ -0,0 +1,149 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrKeyUnavailable is returned when a key provider cannot load its key
+var ErrKeyUnavailable = errors.New("encryption key unavailable")
+
+// KeyProvider loads the secret of an encryption key.
+type KeyProvider interface {
+	Key(ctx context.Context) ([]byte, error)
+}
+
+// InlineKeyProvider returns a key stored in the config.
+type InlineKeyProvider struct {
+	secret []byte
+}
+
+var _ KeyProvider = (*InlineKeyProvider)(nil)
+
+func NewInlineKeyProvider(secret string) *InlineKeyProvider {
+	return &InlineKeyProvider{secret: []byte(secret)}
+}
+
+func (p *InlineKeyProvider) Key(_ context.Context) ([]byte, error) {
+	if len(p.secret) == 0 {
+		return nil, fmt.Errorf("%w: inline key is empty", ErrKeyUnavailable)
+	}
+	return p.secret, nil
+}
+
+// FileKeyProvider reads a key from a file, such as a mounted Kubernetes secret.
+// A trailing newline is ignored unless the file holds exactly KeySize bytes.
+type FileKeyProvider struct {
+	path string
+}
+
+var _ KeyProvider = (*FileKeyProvider)(nil)
+
+func NewFileKeyProvider(path string) *FileKeyProvider {
+	return &FileKeyProvider{path: path}
+}
+
+func (p *FileKeyProvider) Key(_ context.Context) ([]byte, error) {
+	return readKeyFile(p.path)
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrKeyUnavailable, err)
+	}
+	// Binary keys may end with a newline byte, so contents of exactly KeySize bytes are kept as is
+	if len(data) != KeySize {
+		data = bytes.TrimSuffix(data, []byte("\n"))
+		data = bytes.TrimSuffix(data, []byte("\r"))
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: key file %s is empty", ErrKeyUnavailable, path)
+	}
+	return data, nil
+}
+
+// EnvKeyProvider reads a key from an environment variable.
+type EnvKeyProvider struct {
+	name string
+}
+
+var _ KeyProvider = (*EnvKeyProvider)(nil)
+
+func NewEnvKeyProvider(name string) *EnvKeyProvider {
+	return &EnvKeyProvider{name: name}
+}
+
+func (p *EnvKeyProvider) Key(_ context.Context) ([]byte, error) {
+	value, ok := os.LookupEnv(p.name)
+	if !ok || value == "" {
+		return nil, fmt.Errorf("%w: environment variable %s is not set", ErrKeyUnavailable, p.name)
+	}
+	return []byte(value), nil
+}
+
+// EnvelopeKeyProvider unwraps a data key encrypted with a master key read from a local file.
+// The wrapped key is base64 encoded nonce || AES-256-GCM sealed data key, as produced by WrapKey.
+type EnvelopeKeyProvider struct {
+	wrappedKey    string
+	masterKeyPath string
+}
+
+var _ KeyProvider = (*EnvelopeKeyProvider)(nil)
+
+func NewEnvelopeKeyProvider(wrappedKey string, masterKeyPath string) *EnvelopeKeyProvider {
+	return &EnvelopeKeyProvider{wrappedKey: wrappedKey, masterKeyPath: masterKeyPath}
+}
+
+func (p *EnvelopeKeyProvider) Key(_ context.Context) ([]byte, error) {
+	masterKey, err := readKeyFile(p.masterKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("master key: %w", err)
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(p.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: wrapped key is not valid base64", ErrKeyUnavailable)
+	}
+
+	dataKey, err := open(aead, wrapped, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to unwrap data key: %v", ErrKeyUnavailable, err)
+	}
+	return dataKey, nil
+}
+
+// GenerateWrappedKey generates a random data key and wraps it with the master key,
+// returning the base64 encoded wrapped key for EnvelopeKeyProvider.
+func GenerateWrappedKey(masterKey []byte) (string, error) {
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return "", fmt.Errorf("master key: %w", err)
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	wrapped, err := seal(aead, nil, dataKey, nil)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// ReadMasterKey reads a master key file for GenerateWrappedKey.
+func ReadMasterKey(path string) ([]byte, error) {
+	return readKeyFile(path)
+}
@@ -0,0 +1,88 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvelopeKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	masterKeyPath := filepath.Join(dir, "master.key")
+	otherKeyPath := filepath.Join(dir, "other.key")
+	if err := os.WriteFile(masterKeyPath, testSecret(1), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(otherKeyPath, testSecret(2), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	masterKey, err := ReadMasterKey(masterKeyPath)
+	if err != nil {
+		t.Fatalf("ReadMasterKey() error = %v", err)
+	}
+	wrappedKey, err := GenerateWrappedKey(masterKey)
+	if err != nil {
+		t.Fatalf("GenerateWrappedKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		wrappedKey    string
+		masterKeyPath string
+		wantErr       error
+	}{
+		{
+			name:          "unwraps generated key",
+			wrappedKey:    wrappedKey,
+			masterKeyPath: masterKeyPath,
+		},
+		{
+			name:          "wrong master key",
+			wrappedKey:    wrappedKey,
+			masterKeyPath: otherKeyPath,
+			wantErr:       ErrKeyUnavailable,
+		},
+		{
+			name:          "missing master key",
+			wrappedKey:    wrappedKey,
+			masterKeyPath: filepath.Join(dir, "missing.key"),
+			wantErr:       ErrKeyUnavailable,
+		},
+		{
+			name:          "invalid wrapped key",
+			wrappedKey:    "not base64",
+			masterKeyPath: masterKeyPath,
+			wantErr:       ErrKeyUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewEnvelopeKeyProvider(tt.wrappedKey, tt.masterKeyPath).Key(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Key() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(key) != KeySize {
+				t.Fatalf("Key() returned %d bytes, want %d", len(key), KeySize)
+			}
+
+			// The unwrapped key must be stable, otherwise stored tokens could not be decrypted
+			again, err := NewEnvelopeKeyProvider(tt.wrappedKey, tt.masterKeyPath).Key(context.Background())
+			if err != nil || !bytes.Equal(key, again) {
+				t.Fatalf("Key() is not stable: error = %v", err)
+			}
+			keyring := mustKeyring(t, []Key{{Id: "envelope", Secret: key}}, "envelope", nil)
+			plaintext, err := keyring.Decrypt(mustEncrypt(t, keyring, "token"))
+			if err != nil || string(plaintext) != "token" {
+				t.Fatalf("Decrypt() = %q, %v", plaintext, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/rs/zerolog"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
@@ -49,27 +50,7 @@ func (v *DefaultTelegramAuthHashVerifier) Verify(query string, hash string, botT
 		return fmt.Errorf("%w: no fields to verify", service.ErrInvalidTelegramAuthData)
 	}
 
-	// Build data-check-string: sort fields alphabetically and join with "\n"
-	var keys []string
-	for key := range values {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-
-	var dataCheckStringParts []string
-	for _, key := range keys {
-		// url.Values stores lists, but we expect single values per key
-		// Get the first (and typically only) value
-		dataCheckStringParts = append(dataCheckStringParts, fmt.Sprintf("%s=%s", key, values.Get(key)))
-	}
-
-	dataCheckString := ""
-	for i, part := range dataCheckStringParts {
-		if i > 0 {
-			dataCheckString += "\n"
-		}
-		dataCheckString += part
-	}
+	dataCheckString := buildDataCheckString(values)
 
 	// Compute secret_key = SHA256(bot_token)
 	tokenHash := sha256.Sum256([]byte(botToken))
@@ -91,3 +72,21 @@ func (v *DefaultTelegramAuthHashVerifier) Verify(query string, hash string, botT
 	log.Debug().Msg("hash verification successful")
 	return nil
 }
+
+// buildDataCheckString builds the Telegram data-check-string: all fields
+// sorted alphabetically in format "key=<value>" and joined with "\n".
+func buildDataCheckString(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		// url.Values stores lists, but we expect single values per key
+		parts = append(parts, fmt.Sprintf("%s=%s", key, values.Get(key)))
+	}
+
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,113 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// maxBotPhotoSize limits the size of downloaded profile photos. Telegram returns several sizes
+// of a photo, the largest one fitting the limit is chosen.
+const maxBotPhotoSize = 512 * 1024
+
+// fetchBotProfile fetches the descriptions and the current profile photo of the bot.
+func fetchBotProfile(ctx context.Context, bot *gotgbot.Bot, botId int64) (*service.TelegramBotProfile, error) {
+	description, err := bot.GetMyDescriptionWithContext(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot description: %w", err)
+	}
+
+	shortDescription, err := bot.GetMyShortDescriptionWithContext(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot short description: %w", err)
+	}
+
+	photo, err := fetchBotPhoto(ctx, bot, botId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.TelegramBotProfile{
+		Description:      description.Description,
+		ShortDescription: shortDescription.ShortDescription,
+		Photo:            photo,
+	}, nil
+}
+
+// fetchBotPhoto downloads the current profile photo of the bot, returning nil when there is none.
+func fetchBotPhoto(ctx context.Context, bot *gotgbot.Bot, botId int64) (*service.TelegramBotPhoto, error) {
+	photos, err := bot.GetUserProfilePhotosWithContext(ctx, botId, &gotgbot.GetUserProfilePhotosOpts{Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot profile photos: %w", err)
+	}
+	if len(photos.Photos) == 0 {
+		return nil, nil
+	}
+
+	// Sizes are ordered from the smallest one
+	var size *gotgbot.PhotoSize
+	for i, candidate := range photos.Photos[0] {
+		if candidate.FileSize <= maxBotPhotoSize {
+			size = &photos.Photos[0][i]
+		}
+	}
+	if size == nil {
+		return nil, nil
+	}
+
+	file, err := bot.GetFileWithContext(ctx, size.FileId, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot photo file: %w", err)
+	}
+
+	content, err := downloadFile(ctx, file.URL(bot, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.TelegramBotPhoto{
+		FileUniqueId: size.FileUniqueId,
+		Content:      content,
+		ContentType:  http.DetectContentType(content),
+	}, nil
+}
+
+// downloadFile downloads a Telegram file. The URL contains the bot token, so it is never part of returned errors.
+func downloadFile(ctx context.Context, fileUrl string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileUrl, nil)
+	if err != nil {
+		return nil, errors.New("failed to build file download request")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download file: unexpected status %d", resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxBotPhotoSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	if len(content) > maxBotPhotoSize {
+		return nil, errors.New("failed to download file: file is too large")
+	}
+
+	return content, nil
+}
@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+type DefaultTelegramBotWebhook struct {
+	secret string
+}
+
+var _ service.TelegramBotWebhook = (*DefaultTelegramBotWebhook)(nil)
+
+func NewTelegramBotWebhook(secret string) (*DefaultTelegramBotWebhook, error) {
+	if secret == "" {
+		return nil, errors.New("webhook secret cannot be empty")
+	}
+	return &DefaultTelegramBotWebhook{secret: secret}, nil
+}
+
+// SecretToken derives a per-bot secret token as hex(HMAC-SHA256(bot_id, secret)).
+// Telegram allows only [A-Za-z0-9_-] in secret tokens, which hex encoding satisfies.
+func (w *DefaultTelegramBotWebhook) SecretToken(botId int64) string {
+	h := hmac.New(sha256.New, []byte(w.secret))
+	h.Write([]byte(strconv.FormatInt(botId, 10)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (w *DefaultTelegramBotWebhook) Register(ctx context.Context, botToken string, botId int64, url string) error {
+	log := zerolog.Ctx(ctx).With().Str("service", "defaultTelegramBotWebhook").Int64("bot_id", botId).Logger()
+
+	bot, err := gotgbot.NewBot(botToken, &gotgbot.BotOpts{DisableTokenCheck: true})
+	if err != nil {
+		return service.ErrTelegramBotTokenMalformed
+	}
+
+	if _, err := bot.SetWebhookWithContext(ctx, url, &gotgbot.SetWebhookOpts{
+		SecretToken:    w.SecretToken(botId),
+		AllowedUpdates: []string{"message", "callback_query"},
+	}); err != nil {
+		log.Err(err).Msg("failed to set bot webhook")
+		return err
+	}
+
+	log.Debug().Str("url", url).Msg("bot webhook registered")
+	return nil
+}
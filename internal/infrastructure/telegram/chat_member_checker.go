@@ -0,0 +1,112 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PaulSonOfLars/gotgbot/v2"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+type DefaultTelegramChatMemberChecker struct {
+	memberCache service.TelegramChatMemberCache
+}
+
+var _ service.TelegramChatMemberChecker = (*DefaultTelegramChatMemberChecker)(nil)
+
+func NewTelegramChatMemberChecker(memberCache service.TelegramChatMemberCache) *DefaultTelegramChatMemberChecker {
+	return &DefaultTelegramChatMemberChecker{
+		memberCache: memberCache,
+	}
+}
+
+// parseBotId extracts the bot id from the <bot_id>:<secret> token format.
+func (c *DefaultTelegramChatMemberChecker) parseBotId(botToken string) (int64, error) {
+	idPart, _, ok := strings.Cut(botToken, ":")
+	if !ok {
+		return 0, service.ErrTelegramBotTokenMalformed
+	}
+	botId, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || botId <= 0 {
+		return 0, service.ErrTelegramBotTokenMalformed
+	}
+	return botId, nil
+}
+
+func (c *DefaultTelegramChatMemberChecker) cacheChatMember(botId, chatId, userId int64, member *service.TelegramChatMember) {
+	if c.memberCache == nil {
+		return
+	}
+
+	go func() {
+		bgCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := c.memberCache.CacheChatMember(bgCtx, botId, chatId, userId, member); err != nil {
+			zerolog.Ctx(bgCtx).Err(err).Str("service", "defaultTelegramChatMemberChecker").Msg("failed to cache chat member")
+		}
+	}()
+}
+
+func (c *DefaultTelegramChatMemberChecker) GetChatMember(ctx context.Context, botToken string, chatId, userId int64) (*service.TelegramChatMember, error) {
+	log := zerolog.Ctx(ctx).With().
+		Str("service", "defaultTelegramChatMemberChecker").
+		Int64("chat_id", chatId).
+		Int64("user_id", userId).
+		Logger()
+
+	botId, err := c.parseBotId(botToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.memberCache != nil {
+		member, err := c.memberCache.GetChatMember(ctx, botId, chatId, userId)
+		if err == nil {
+			return member, nil
+		}
+		if !errors.Is(err, service.ErrChatMemberNotInCache) {
+			log.Err(err).Msg("failed to check cache, continuing to Telegram API")
+		}
+	}
+
+	bot, err := gotgbot.NewBot(botToken, &gotgbot.BotOpts{DisableTokenCheck: true})
+	if err != nil {
+		return nil, service.ErrTelegramBotTokenMalformed
+	}
+
+	chatMember, err := bot.GetChatMemberWithContext(ctx, chatId, userId, nil)
+	if err != nil {
+		var tgErr *gotgbot.TelegramError
+		if errors.As(err, &tgErr) {
+			// Telegram reports unknown users as "user not found" and inaccessible chats as "chat not found"
+			if strings.Contains(strings.ToLower(tgErr.Description), "user not found") {
+				member := &service.TelegramChatMember{Status: service.TelegramChatMemberStatusLeft}
+				c.cacheChatMember(botId, chatId, userId, member)
+				return member, nil
+			}
+			if strings.Contains(strings.ToLower(tgErr.Description), "chat not found") {
+				return nil, service.ErrTelegramChatNotFound
+			}
+		}
+		log.Err(err).Msg("failed to get chat member")
+		return nil, err
+	}
+
+	merged := chatMember.MergeChatMember()
+	member := &service.TelegramChatMember{Status: service.TelegramChatMemberStatus(merged.Status)}
+	switch member.Status {
+	case service.TelegramChatMemberStatusCreator,
+		service.TelegramChatMemberStatusAdministrator,
+		service.TelegramChatMemberStatusMember:
+		member.IsMember = true
+	case service.TelegramChatMemberStatusRestricted:
+		member.IsMember = merged.IsMember
+	}
+
+	c.cacheChatMember(botId, chatId, userId, member)
+	return member, nil
+}
@@ -0,0 +1,106 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+type DefaultTelegramMiniAppDataParser struct{}
+
+var _ service.TelegramMiniAppDataParser = (*DefaultTelegramMiniAppDataParser)(nil)
+
+func NewTelegramMiniAppDataParser() *DefaultTelegramMiniAppDataParser {
+	return &DefaultTelegramMiniAppDataParser{}
+}
+
+// miniAppUser mirrors the WebAppUser object serialized into the "user" field of initData.
+type miniAppUser struct {
+	Id           int64   `json:"id"`
+	FirstName    string  `json:"first_name"`
+	LastName     *string `json:"last_name"`
+	Username     *string `json:"username"`
+	LanguageCode *string `json:"language_code"`
+	IsPremium    *bool   `json:"is_premium"`
+	PhotoUrl     *string `json:"photo_url"`
+}
+
+func (p *DefaultTelegramMiniAppDataParser) Parse(params map[string]any) (*service.TelegramAuthData, error) {
+	var output service.TelegramAuthData
+
+	hash, ok := params["hash"].(string)
+	if !ok || hash == "" {
+		return nil, fmt.Errorf("invalid 'hash' parameter: %w", service.ErrInvalidTelegramAuthData)
+	}
+	output.Hash = hash
+
+	if signature, ok := params["signature"].(string); ok {
+		output.Signature = signature
+	}
+
+	// Build raw string without hash (verifier will sort keys for verification)
+	var rawParts []string
+	for key, value := range params {
+		if key != "hash" {
+			valueStr := fmt.Sprintf("%v", value)
+			rawParts = append(rawParts, url.QueryEscape(key)+"="+url.QueryEscape(valueStr))
+		}
+	}
+	output.Raw = strings.Join(rawParts, "&")
+
+	authDateInt64, err := parseIntField(params, "auth_date")
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'auth_date': %w", err)
+	}
+	output.AuthDate = time.Unix(authDateInt64, 0)
+
+	rawUser, ok := params["user"].(string)
+	if !ok || rawUser == "" {
+		return nil, fmt.Errorf("invalid 'user': %w", service.ErrInvalidTelegramAuthData)
+	}
+
+	var tgUser miniAppUser
+	if err := json.Unmarshal([]byte(rawUser), &tgUser); err != nil {
+		return nil, fmt.Errorf("invalid 'user' json: %w", service.ErrInvalidTelegramAuthData)
+	}
+	if tgUser.Id <= 0 {
+		return nil, fmt.Errorf("invalid 'user.id': %w", service.ErrInvalidTelegramAuthData)
+	}
+	if tgUser.FirstName == "" {
+		return nil, fmt.Errorf("invalid 'user.first_name': %w", service.ErrInvalidTelegramAuthData)
+	}
+
+	user := &service.TelegramUserData{
+		Id:        tgUser.Id,
+		FirstName: tgUser.FirstName,
+		IsPremium: tgUser.IsPremium,
+	}
+
+	if tgUser.LastName != nil && *tgUser.LastName != "" {
+		user.LastName = tgUser.LastName
+	}
+
+	if tgUser.Username != nil && *tgUser.Username != "" {
+		user.Username = tgUser.Username
+	}
+
+	if tgUser.LanguageCode != nil && *tgUser.LanguageCode != "" {
+		user.LanguageCode = tgUser.LanguageCode
+	}
+
+	if tgUser.PhotoUrl != nil && *tgUser.PhotoUrl != "" {
+		photoUrl, err := url.Parse(*tgUser.PhotoUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'user.photo_url': %w", service.ErrInvalidTelegramAuthData)
+		}
+		user.PhotoUrl = photoUrl
+	}
+
+	output.User = user
+
+	return &output, nil
+}
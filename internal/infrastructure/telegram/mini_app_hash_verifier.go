@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// miniAppSecretKeyConstant is the HMAC key used to derive the Mini App secret key from the bot token.
+const miniAppSecretKeyConstant = "WebAppData"
+
+// DefaultTelegramMiniAppAuthHashVerifier implements TelegramMiniAppAuthHashVerifier
+// using the official Telegram Mini App init data verification algorithm.
+type DefaultTelegramMiniAppAuthHashVerifier struct{}
+
+var _ service.TelegramMiniAppAuthHashVerifier = (*DefaultTelegramMiniAppAuthHashVerifier)(nil)
+
+// NewTelegramMiniAppAuthHashVerifier creates a new Telegram Mini App hash verifier.
+func NewTelegramMiniAppAuthHashVerifier() *DefaultTelegramMiniAppAuthHashVerifier {
+	return &DefaultTelegramMiniAppAuthHashVerifier{}
+}
+
+// Verify verifies the HMAC-SHA256 signature of Telegram Mini App init data.
+// According to Telegram documentation:
+// - query: init data query string without hash parameter
+// - hash: the provided HMAC-SHA256 signature (hex-encoded)
+// - botToken: the Telegram bot token
+// - Creates a data-check-string: all fields sorted alphabetically in format "key=<value>\n"
+// - Computes secret_key: HMAC-SHA256(bot_token, "WebAppData")
+// - Verifies: hex(HMAC-SHA256(data_check_string, secret_key)) == provided hash
+func (v *DefaultTelegramMiniAppAuthHashVerifier) Verify(query string, hash string, botToken string) error {
+	if hash == "" {
+		return fmt.Errorf("%w: hash parameter missing", service.ErrInvalidTelegramAuthData)
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("%w: invalid query format", service.ErrInvalidTelegramAuthData)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("%w: no fields to verify", service.ErrInvalidTelegramAuthData)
+	}
+
+	dataCheckString := buildDataCheckString(values)
+
+	// Compute secret_key = HMAC-SHA256(bot_token, "WebAppData")
+	secretMac := hmac.New(sha256.New, []byte(miniAppSecretKeyConstant))
+	secretMac.Write([]byte(botToken))
+	secretKey := secretMac.Sum(nil)
+
+	// Compute HMAC-SHA256(data_check_string, secret_key)
+	h := hmac.New(sha256.New, secretKey)
+	h.Write([]byte(dataCheckString))
+	computedHash := hex.EncodeToString(h.Sum(nil))
+
+	if !hmac.Equal([]byte(computedHash), []byte(hash)) {
+		return service.ErrInvalidTelegramAuthData
+	}
+
+	return nil
+}
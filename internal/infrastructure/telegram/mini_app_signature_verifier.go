@@ -0,0 +1,73 @@
+package telegram
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// Ed25519TelegramMiniAppSignatureVerifier implements TelegramMiniAppSignatureVerifier
+// using Telegram's published Ed25519 public key.
+type Ed25519TelegramMiniAppSignatureVerifier struct {
+	publicKey ed25519.PublicKey
+}
+
+var _ service.TelegramMiniAppSignatureVerifier = (*Ed25519TelegramMiniAppSignatureVerifier)(nil)
+
+// NewTelegramMiniAppSignatureVerifier creates a new verifier from a hex-encoded Ed25519 public key.
+func NewTelegramMiniAppSignatureVerifier(publicKeyHex string) (*Ed25519TelegramMiniAppSignatureVerifier, error) {
+	publicKey, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key encoding: %w", err)
+	}
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("public key must be 32 bytes for Ed25519")
+	}
+	return &Ed25519TelegramMiniAppSignatureVerifier{
+		publicKey: ed25519.PublicKey(publicKey),
+	}, nil
+}
+
+// Verify verifies the Ed25519 third-party signature of Telegram Mini App init data.
+// According to Telegram documentation:
+// - query: init data query string without hash parameter
+// - signature: the provided Ed25519 signature (base64url-encoded, without padding)
+// - botId: the numeric id of the bot the Mini App belongs to
+// - Creates a data-check-string: "<bot_id>:WebAppData\n" followed by all fields
+// except hash and signature, sorted alphabetically in format "key=<value>\n"
+// - Verifies the signature of the data-check-string with Telegram's public key
+func (v *Ed25519TelegramMiniAppSignatureVerifier) Verify(query string, signature string, botId int64) error {
+	if signature == "" {
+		return fmt.Errorf("%w: signature parameter missing", service.ErrInvalidTelegramAuthData)
+	}
+
+	signatureBytes, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: invalid signature encoding", service.ErrInvalidTelegramAuthData)
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("%w: invalid query format", service.ErrInvalidTelegramAuthData)
+	}
+	values.Del("hash")
+	values.Del("signature")
+
+	if len(values) == 0 {
+		return fmt.Errorf("%w: no fields to verify", service.ErrInvalidTelegramAuthData)
+	}
+
+	dataCheckString := strconv.FormatInt(botId, 10) + ":" + miniAppSecretKeyConstant + "\n" + buildDataCheckString(values)
+
+	if !ed25519.Verify(v.publicKey, []byte(dataCheckString), signatureBytes) {
+		return service.ErrInvalidTelegramAuthData
+	}
+
+	return nil
+}
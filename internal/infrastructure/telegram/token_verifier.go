@@ -3,6 +3,8 @@ package telegram
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/PaulSonOfLars/gotgbot/v2"
@@ -83,12 +85,19 @@ func (s *DefaultTelegramTokenVerifier) Verify(ctx context.Context, token string,
 		return nil, service.ErrTelegramBotTokenMalformed
 	}
 
-	me, err := bot.GetMe(nil)
+	me, err := bot.GetMeWithContext(ctx, nil)
 	if err != nil {
-		log.Err(err).Msg("failed to call GetMe with provided token, token is likely invalid")
-		s.cacheTokenInvalid(token)
-
-		return nil, service.ErrTelegramBotTokenInvalid
+		// Telegram answers 401 to revoked tokens and 404 to tokens of unknown bots. Other failures,
+		// such as network errors, say nothing about the token and are not cached.
+		var tgErr *gotgbot.TelegramError
+		if errors.As(err, &tgErr) && (tgErr.Code == http.StatusUnauthorized || tgErr.Code == http.StatusNotFound) {
+			log.Err(err).Msg("Telegram rejected the provided token")
+			s.cacheTokenInvalid(token)
+
+			return nil, service.ErrTelegramBotTokenInvalid
+		}
+		log.Err(err).Msg("failed to call GetMe with provided token")
+		return nil, fmt.Errorf("failed to call GetMe: %w", err)
 	}
 	if !me.IsBot {
 		return nil, errors.New("provided token does not belong to a bot")
@@ -98,7 +107,19 @@ func (s *DefaultTelegramTokenVerifier) Verify(ctx context.Context, token string,
 	botInfo.Id = me.Id
 	botInfo.Name = me.FirstName
 	botInfo.Username = me.Username
+	botInfo.CanJoinGroups = me.CanJoinGroups
+	botInfo.SupportsInlineQueries = me.SupportsInlineQueries
 	s.cacheTokenValid(token)
 
+	// The token is valid at this point, so a profile that cannot be fetched is left out instead of failing
+	if opts.WithProfile {
+		profile, err := fetchBotProfile(ctx, bot, me.Id)
+		if err != nil {
+			log.Warn().Err(err).Int64("bot_id", me.Id).Msg("failed to fetch bot profile")
+		} else {
+			botInfo.Profile = profile
+		}
+	}
+
 	return &botInfo, nil
 }
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+)
+
+// Security scheme names declared in api/openapi.yaml.
+const (
+	AdminApiKeySecurityScheme    = "adminApiKey"
+	AdminSignatureSecurityScheme = "adminSignature"
+)
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// NewAuthenticationFunc authenticates operations that declare security requirements in the spec
+// using the authenticator registered for the security scheme. Operations without security
+// requirements (public ones) are never passed to it.
+func NewAuthenticationFunc(authenticators map[string]service.AdminAuthenticator) openapi3filter.AuthenticationFunc {
+	return func(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+		authenticator, ok := authenticators[input.SecuritySchemeName]
+		if !ok {
+			return fmt.Errorf("security scheme %q is not supported", input.SecuritySchemeName)
+		}
+
+		req := input.RequestValidationInput.Request
+		ctx := req.Context()
+
+		body, err := readRequestBody(req)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "failed to read request body")
+		}
+
+		principal, err := authenticator.Authenticate(ctx, &service.AdminAuthRequest{
+			Method:     req.Method,
+			RequestUri: req.URL.RequestURI(),
+			Header:     req.Header,
+			Body:       body,
+		})
+		if err != nil {
+			if errors.Is(err, service.ErrAdminCredentialsMissing) {
+				// Let another security scheme of the operation authenticate the request
+				return err
+			}
+			if errors.Is(err, service.ErrAdminCredentialsInvalid) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "admin credentials are invalid")
+			}
+			zerolog.Ctx(ctx).Error().
+				Err(err).
+				Str("security_scheme", input.SecuritySchemeName).
+				Msg("failed to authenticate admin request")
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to authenticate request")
+		}
+
+		if !principal.HasScopes(input.Scopes) {
+			zerolog.Ctx(ctx).Warn().
+				Str("key_id", principal.KeyId).
+				Strs("required_scopes", input.Scopes).
+				Msg("admin key lacks required scopes")
+			return echo.NewHTTPError(http.StatusForbidden, "admin credentials lack the required scope")
+		}
+
+		zerolog.Ctx(ctx).Debug().
+			Str("key_id", principal.KeyId).
+			Str("security_scheme", input.SecuritySchemeName).
+			Msg("admin request authenticated")
+		return nil
+	}
+}
+
+// ValidationErrorHandler renders request validation and authentication errors as ErrorResponse.
+func ValidationErrorHandler(c echo.Context, err *echo.HTTPError) error {
+	code := err.Code
+	message := fmt.Sprint(err.Message)
+
+	// None of the security schemes found credentials in the request
+	var securityErr *openapi3filter.SecurityRequirementsError
+	if code == http.StatusForbidden && errors.As(err.Internal, &securityErr) {
+		code = http.StatusUnauthorized
+		message = "admin credentials are missing"
+	}
+
+	return c.JSON(code, generated.ErrorResponse{Message: message})
+}
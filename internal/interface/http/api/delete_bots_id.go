@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Delete bot
+// (DELETE /bots/{id})
+func (s *server) DeleteBotsId(ctx context.Context, request generated.DeleteBotsIdRequestObject) (generated.DeleteBotsIdResponseObject, error) {
+	_, err := s.deleteBot.Execute(ctx, &usecase.DeleteBotInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.DeleteBotsId404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.DeleteBotsId500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.DeleteBotsId204Response{}, nil
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Delete access policy of the bot
+// (DELETE /bots/{id}/access-policy)
+func (s *server) DeleteBotsIdAccessPolicy(ctx context.Context, request generated.DeleteBotsIdAccessPolicyRequestObject) (generated.DeleteBotsIdAccessPolicyResponseObject, error) {
+	_, err := s.deleteBotAccessPolicy.Execute(ctx, &usecase.DeleteBotAccessPolicyInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.DeleteBotsIdAccessPolicy404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.DeleteBotsIdAccessPolicy500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.DeleteBotsIdAccessPolicy204Response{}, nil
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Unlink bot from OIDC client
+// (DELETE /bots/{id}/client)
+func (s *server) DeleteBotsIdClient(ctx context.Context, request generated.DeleteBotsIdClientRequestObject) (generated.DeleteBotsIdClientResponseObject, error) {
+	_, err := s.unlinkBotFromClient.Execute(ctx, &usecase.UnlinkBotFromClientInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.DeleteBotsIdClient404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.DeleteBotsIdClient500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.DeleteBotsIdClient204Response{}, nil
+}
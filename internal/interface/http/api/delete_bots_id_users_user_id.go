@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Delete bot user
+// (DELETE /bots/{id}/users/{user_id})
+func (s *server) DeleteBotsIdUsersUserId(ctx context.Context, request generated.DeleteBotsIdUsersUserIdRequestObject) (generated.DeleteBotsIdUsersUserIdResponseObject, error) {
+	_, err := s.deleteBotUser.Execute(ctx, &usecase.DeleteBotUserInput{
+		BotId:  request.Id,
+		UserId: request.UserId,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.DeleteBotsIdUsersUserId404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.DeleteBotsIdUsersUserId500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.DeleteBotsIdUsersUserId204Response{}, nil
+}
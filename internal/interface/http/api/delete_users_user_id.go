@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Erase data of a Telegram user
+// (DELETE /users/{user_id})
+func (s *server) DeleteUsersUserId(ctx context.Context, request generated.DeleteUsersUserIdRequestObject) (generated.DeleteUsersUserIdResponseObject, error) {
+	output, err := s.eraseUserData.Execute(ctx, &usecase.EraseUserDataInput{
+		UserId: request.UserId,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.DeleteUsersUserId400JSONResponse(*resp), nil
+		case http.StatusBadGateway:
+			return generated.DeleteUsersUserId502JSONResponse(*resp), nil
+		case http.StatusGatewayTimeout:
+			return generated.DeleteUsersUserId504JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.DeleteUsersUserId500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.DeleteUsersUserId200JSONResponse{
+		DeletedBotUsers:    output.DeletedBotUsers,
+		DeletedLoginEvents: output.DeletedLoginEvents,
+	}, nil
+}
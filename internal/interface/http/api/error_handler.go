@@ -3,6 +3,7 @@ package api
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 
 	"github.com/ulbwa/telegram-oidc-provider/api/generated"
@@ -53,6 +54,27 @@ func handleError(err error) (int, *generated.ErrorResponse, error) {
 		return http.StatusConflict, &resp, nil
 	}
 
+	var badGatewayErr *usecase.BadGatewayErr
+	if errors.As(err, &badGatewayErr) {
+		var resp generated.ErrorResponse
+		resp.Message = badGatewayErr.Error()
+		return http.StatusBadGateway, &resp, nil
+	}
+
+	var gatewayTimeoutErr *usecase.GatewayTimeoutErr
+	if errors.As(err, &gatewayTimeoutErr) {
+		var resp generated.ErrorResponse
+		resp.Message = gatewayTimeoutErr.Error()
+		return http.StatusGatewayTimeout, &resp, nil
+	}
+
+	var tooManyRequestsErr *usecase.TooManyRequestsErr
+	if errors.As(err, &tooManyRequestsErr) {
+		var resp generated.ErrorResponse
+		resp.Message = tooManyRequestsErr.Error()
+		return http.StatusTooManyRequests, &resp, nil
+	}
+
 	if errors.Is(err, usecase.ErrInvalidInput) {
 		var resp generated.ErrorResponse
 		resp.Message = err.Error()
@@ -67,3 +89,12 @@ func handleError(err error) (int, *generated.ErrorResponse, error) {
 
 	return 0, nil, err
 }
+
+// retryAfterSeconds returns the Retry-After value of a rate limited request, rounded up to a second.
+func retryAfterSeconds(err error) int {
+	var tooManyRequestsErr *usecase.TooManyRequestsErr
+	if !errors.As(err, &tooManyRequestsErr) {
+		return 1
+	}
+	return max(1, int(math.Ceil(tooManyRequestsErr.RetryAfter.Seconds())))
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
+)
+
+// Complete login confirmed in the Telegram bot
+// (GET /bot/callback)
+func (s *server) GetBotCallback(ctx context.Context, request generated.GetBotCallbackRequestObject) (generated.GetBotCallbackResponseObject, error) {
+	input := usecase.LoginByBotInput{
+		LoginChallenge: request.Params.LoginChallenge,
+		Nonce:          request.Params.Nonce,
+		UserAgent:      request.Params.UserAgent,
+		Language:       normalizeBCP47LanguagePtr(request.Params.AcceptLanguage),
+		ClientIP:       clientip.FromContext(ctx),
+	}
+
+	output, err := s.loginByBot.Execute(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Pending {
+		return generated.GetBotCallback202Response{}, nil
+	}
+
+	var resp generated.GetBotCallback203Response
+	resp.Headers.Location = output.RedirectUri
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// List registered bots
+// (GET /bots)
+func (s *server) GetBots(ctx context.Context, request generated.GetBotsRequestObject) (generated.GetBotsResponseObject, error) {
+	output, err := s.listBots.Execute(ctx, &usecase.ListBotsInput{
+		Linked:         request.Params.Linked,
+		UsernamePrefix: request.Params.UsernamePrefix,
+		Cursor:         request.Params.Cursor,
+		Limit:          request.Params.Limit,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.GetBots400JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBots500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	httpResp := generated.GetBots200JSONResponse{
+		Items:      make([]generated.BotBriefResponse, 0, len(output.Bots)),
+		NextCursor: output.NextCursor,
+	}
+	for _, bot := range output.Bots {
+		httpResp.Items = append(httpResp.Items, generated.BotBriefResponse{
+			Id:       bot.Id,
+			Name:     bot.Name,
+			Username: bot.Username,
+			ClientId: bot.ClientId,
+
+			Status:            generated.BotStatus(bot.Status),
+			CredentialsStatus: generated.BotCredentialsStatus(bot.CredentialsStatus),
+		})
+	}
+	return httpResp, nil
+}
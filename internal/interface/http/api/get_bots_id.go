@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Get bot
+// (GET /bots/{id})
+func (s *server) GetBotsId(ctx context.Context, request generated.GetBotsIdRequestObject) (generated.GetBotsIdResponseObject, error) {
+	output, err := s.getBot.Execute(ctx, &usecase.GetBotInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.GetBotsId404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsId500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.GetBotsId200JSONResponse{
+		Id:       output.Bot.Id,
+		Name:     output.Bot.Name,
+		Username: output.Bot.Username,
+		ClientId: output.Bot.ClientId,
+		PhotoUrl: output.PhotoUrl,
+		Url:      output.Url,
+
+		Description:           output.Bot.Description,
+		ShortDescription:      output.Bot.ShortDescription,
+		CanJoinGroups:         output.Bot.CanJoinGroups,
+		SupportsInlineQueries: output.Bot.SupportsInlineQueries,
+
+		Status:               generated.BotStatus(output.Bot.Status),
+		StatusReason:         output.Bot.StatusReason,
+		StatusChangedAt:      output.Bot.StatusChangedAt,
+		CredentialsStatus:    generated.BotCredentialsStatus(output.Bot.CredentialsStatus),
+		CredentialsCheckedAt: output.Bot.CredentialsCheckedAt,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+func mapBotAccessPolicy(policy *entity.BotAccessPolicy) generated.BotAccessPolicyResponse {
+	return generated.BotAccessPolicyResponse{
+		AllowedUserIds:   policy.AllowedUserIds,
+		DeniedUserIds:    policy.DeniedUserIds,
+		RequireUsername:  policy.RequireUsername,
+		RequirePremium:   policy.RequirePremium,
+		AllowedLanguages: policy.AllowedLanguages,
+		CreatedAt:        policy.CreatedAt,
+		UpdatedAt:        policy.UpdatedAt,
+	}
+}
+
+// Get access policy of the bot
+// (GET /bots/{id}/access-policy)
+func (s *server) GetBotsIdAccessPolicy(ctx context.Context, request generated.GetBotsIdAccessPolicyRequestObject) (generated.GetBotsIdAccessPolicyResponseObject, error) {
+	output, err := s.getBotAccessPolicy.Execute(ctx, &usecase.GetBotAccessPolicyInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.GetBotsIdAccessPolicy404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsIdAccessPolicy500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.GetBotsIdAccessPolicy200JSONResponse(mapBotAccessPolicy(output.Policy)), nil
+}
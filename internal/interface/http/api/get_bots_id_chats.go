@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+func mapBotChats(chats []*entity.BotChat) generated.BotChatList {
+	list := generated.BotChatList{
+		Items: make([]generated.BotChat, 0, len(chats)),
+	}
+	for _, chat := range chats {
+		list.Items = append(list.Items, generated.BotChat{
+			ChatId: chat.ChatId,
+			Group:  chat.Group,
+		})
+	}
+	return list
+}
+
+// List Telegram chats of the bot
+// (GET /bots/{id}/chats)
+func (s *server) GetBotsIdChats(ctx context.Context, request generated.GetBotsIdChatsRequestObject) (generated.GetBotsIdChatsResponseObject, error) {
+	output, err := s.getBotChats.Execute(ctx, &usecase.GetBotChatsInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.GetBotsIdChats404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsIdChats500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.GetBotsIdChats200JSONResponse(mapBotChats(output.Chats)), nil
+}
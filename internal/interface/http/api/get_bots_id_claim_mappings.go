@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+func mapBotClaimMappings(mappings []*entity.BotClaimMapping) generated.BotClaimMappingList {
+	list := generated.BotClaimMappingList{
+		Items: make([]generated.BotClaimMapping, 0, len(mappings)),
+	}
+	for _, mapping := range mappings {
+		list.Items = append(list.Items, generated.BotClaimMapping{
+			Scope:  mapping.Scope,
+			Claim:  mapping.Claim,
+			Source: generated.BotClaimMappingSource(mapping.Source),
+		})
+	}
+	return list
+}
+
+// List scope to claim mappings of the bot
+// (GET /bots/{id}/claim-mappings)
+func (s *server) GetBotsIdClaimMappings(ctx context.Context, request generated.GetBotsIdClaimMappingsRequestObject) (generated.GetBotsIdClaimMappingsResponseObject, error) {
+	output, err := s.getBotClaimMappings.Execute(ctx, &usecase.GetBotClaimMappingsInput{
+		BotId: request.Id,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.GetBotsIdClaimMappings404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsIdClaimMappings500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.GetBotsIdClaimMappings200JSONResponse(mapBotClaimMappings(output.Mappings)), nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// List users signed in through the bot
+// (GET /bots/{id}/users)
+func (s *server) GetBotsIdUsers(ctx context.Context, request generated.GetBotsIdUsersRequestObject) (generated.GetBotsIdUsersResponseObject, error) {
+	output, err := s.listBotUsers.Execute(ctx, &usecase.ListBotUsersInput{
+		BotId:     request.Id,
+		Search:    request.Params.Search,
+		Ascending: request.Params.Order != nil && *request.Params.Order == generated.LastLoginAsc,
+		Cursor:    request.Params.Cursor,
+		Limit:     request.Params.Limit,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.GetBotsIdUsers400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.GetBotsIdUsers404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsIdUsers500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	httpResp := generated.GetBotsIdUsers200JSONResponse{
+		Items:      make([]generated.BotUserResponse, 0, len(output.Users)),
+		NextCursor: output.NextCursor,
+	}
+	for _, botUser := range output.Users {
+		httpResp.Items = append(httpResp.Items, mapBotUser(botUser))
+	}
+	return httpResp, nil
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// Get bot user
+// (GET /bots/{id}/users/{user_id})
+func (s *server) GetBotsIdUsersUserId(ctx context.Context, request generated.GetBotsIdUsersUserIdRequestObject) (generated.GetBotsIdUsersUserIdResponseObject, error) {
+	output, err := s.getBotUser.Execute(ctx, &usecase.GetBotUserInput{
+		BotId:  request.Id,
+		UserId: request.UserId,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusNotFound:
+			return generated.GetBotsIdUsersUserId404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetBotsIdUsersUserId500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.GetBotsIdUsersUserId200JSONResponse(mapBotUser(output.User)), nil
+}
+
+func mapBotUser(botUser *entity.BotUser) generated.BotUserResponse {
+	resp := generated.BotUserResponse{
+		UserId:      botUser.UserId,
+		FirstName:   botUser.User.FirstName,
+		LastName:    botUser.User.LastName,
+		Username:    botUser.User.Username,
+		IsPremium:   botUser.User.IsPremium,
+		Language:    botUser.Language,
+		Ip:          botUser.IP.String(),
+		UserAgent:   botUser.UserAgent,
+		LastLoginAt: botUser.LastLoginAt,
+		CreatedAt:   botUser.CreatedAt,
+		UpdatedAt:   botUser.UpdatedAt,
+	}
+	if botUser.User.PhotoUrl != nil {
+		resp.PhotoUrl = utils.Ptr(botUser.User.PhotoUrl.String())
+	}
+	return resp
+}
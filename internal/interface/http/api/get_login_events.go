@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// List login events
+// (GET /login-events)
+func (s *server) GetLoginEvents(ctx context.Context, request generated.GetLoginEventsRequestObject) (generated.GetLoginEventsResponseObject, error) {
+	input := usecase.ListLoginEventsInput{
+		BotId:    request.Params.BotId,
+		UserId:   request.Params.UserId,
+		ClientId: request.Params.ClientId,
+		Since:    request.Params.Since,
+		Until:    request.Params.Until,
+		Cursor:   request.Params.Cursor,
+		Limit:    request.Params.Limit,
+	}
+	if request.Params.Outcome != nil {
+		input.Outcome = utils.Ptr(entity.LoginOutcome(*request.Params.Outcome))
+	}
+
+	output, err := s.listLoginEvents.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.GetLoginEvents400JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetLoginEvents500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	httpResp := generated.GetLoginEvents200JSONResponse{
+		Items:      make([]generated.LoginEvent, 0, len(output.Events)),
+		NextCursor: output.NextCursor,
+	}
+	for _, event := range output.Events {
+		httpResp.Items = append(httpResp.Items, mapLoginEvent(event))
+	}
+	return httpResp, nil
+}
+
+func mapLoginEvent(event *entity.LoginEvent) generated.LoginEvent {
+	resp := generated.LoginEvent{
+		Id:        event.Id,
+		Method:    generated.LoginEventMethod(event.Method),
+		Outcome:   generated.LoginEventOutcome(event.Outcome),
+		ClientId:  event.ClientId,
+		BotId:     event.BotId,
+		UserId:    event.UserId,
+		ErrorCode: event.ErrorCode,
+		UserAgent: event.UserAgent,
+		CreatedAt: event.CreatedAt,
+	}
+	if event.IP != nil {
+		resp.Ip = utils.Ptr(event.IP.String())
+	}
+	return resp
+}
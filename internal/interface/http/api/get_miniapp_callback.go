@@ -2,13 +2,45 @@ package api
 
 import (
 	"context"
-	"errors"
+	"net/url"
 
 	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
 )
 
+// parseMiniAppInitData converts raw Mini App init data into a flat parameter map.
+// Malformed init data yields an empty map, which the usecase rejects via Hydra.
+func parseMiniAppInitData(initData string) map[string]any {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	params := make(map[string]any, len(values))
+	for key := range values {
+		params[key] = values.Get(key)
+	}
+	return params
+}
+
 // Login user by telegram mini app auth data
 // (GET /miniapp/callback)
 func (s *server) GetMiniappCallback(ctx context.Context, request generated.GetMiniappCallbackRequestObject) (generated.GetMiniappCallbackResponseObject, error) {
-	return nil, errors.New("not implemented")
+	input := usecase.LoginByMiniAppInput{
+		LoginChallenge: request.Params.LoginChallenge,
+		AuthData:       parseMiniAppInitData(request.Params.InitData),
+		UserAgent:      request.Params.UserAgent,
+		Language:       normalizeBCP47LanguagePtr(request.Params.AcceptLanguage),
+		ClientIP:       clientip.FromContext(ctx),
+	}
+
+	output, err := s.loginByMiniApp.Execute(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp generated.GetMiniappCallback203Response
+	resp.Headers.Location = output.RedirectUri
+	return resp, nil
 }
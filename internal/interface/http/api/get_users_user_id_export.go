@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Export data of a Telegram user
+// (GET /users/{user_id}/export)
+func (s *server) GetUsersUserIdExport(ctx context.Context, request generated.GetUsersUserIdExportRequestObject) (generated.GetUsersUserIdExportResponseObject, error) {
+	output, err := s.exportUserData.Execute(ctx, &usecase.ExportUserDataInput{
+		UserId: request.UserId,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.GetUsersUserIdExport400JSONResponse(*resp), nil
+		case http.StatusBadGateway:
+			return generated.GetUsersUserIdExport502JSONResponse(*resp), nil
+		case http.StatusGatewayTimeout:
+			return generated.GetUsersUserIdExport504JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.GetUsersUserIdExport500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	httpResp := generated.GetUsersUserIdExport200JSONResponse{
+		UserId:        output.UserId,
+		BotUsers:      make([]generated.UserDataExportBotUser, 0, len(output.BotUsers)),
+		LoginEvents:   make([]generated.LoginEvent, 0, len(output.LoginEvents)),
+		ConsentGrants: make([]generated.ConsentGrant, 0, len(output.ConsentGrants)),
+	}
+	for _, botUser := range output.BotUsers {
+		user := mapBotUser(botUser)
+		httpResp.BotUsers = append(httpResp.BotUsers, generated.UserDataExportBotUser{
+			BotId:       botUser.BotId,
+			UserId:      user.UserId,
+			FirstName:   user.FirstName,
+			LastName:    user.LastName,
+			Username:    user.Username,
+			PhotoUrl:    user.PhotoUrl,
+			IsPremium:   user.IsPremium,
+			Language:    user.Language,
+			Ip:          user.Ip,
+			UserAgent:   user.UserAgent,
+			LastLoginAt: user.LastLoginAt,
+			CreatedAt:   user.CreatedAt,
+			UpdatedAt:   user.UpdatedAt,
+		})
+	}
+	for _, event := range output.LoginEvents {
+		httpResp.LoginEvents = append(httpResp.LoginEvents, mapLoginEvent(event))
+	}
+	for _, grant := range output.ConsentGrants {
+		httpResp.ConsentGrants = append(httpResp.ConsentGrants, generated.ConsentGrant{
+			ClientId:        grant.ClientId,
+			ClientName:      grant.ClientName,
+			GrantedScopes:   nonNilStrings(grant.GrantedScopes),
+			GrantedAudience: nonNilStrings(grant.GrantedAudience),
+			Remember:        grant.Remember,
+			RememberFor:     grant.RememberFor,
+			HandledAt:       grant.HandledAt,
+		})
+	}
+	return httpResp, nil
+}
+
+// nonNilStrings returns an empty slice instead of nil so that it is serialized as an empty JSON array.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
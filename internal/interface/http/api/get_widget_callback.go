@@ -2,16 +2,14 @@ package api
 
 import (
 	"context"
-	"net/netip"
 	"strings"
 
 	"github.com/ulbwa/telegram-oidc-provider/api/generated"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
 	xlanguage "golang.org/x/text/language"
 )
 
-var defaultClientIP = netip.MustParseAddr("127.0.0.1")
-
 func normalizeBCP47Language(value *string) string {
 	if value == nil {
 		return ""
@@ -56,7 +54,7 @@ func (s *server) GetWidgetCallback(ctx context.Context, request generated.GetWid
 		AuthData:       request.Params.TelegramWidgetAuthData,
 		UserAgent:      request.Params.UserAgent,
 		Language:       normalizeBCP47LanguagePtr(request.Params.AcceptLanguage),
-		ClientIP:       defaultClientIP,
+		ClientIP:       clientip.FromContext(ctx),
 	}
 
 	output, err := s.loginByWidget.Execute(ctx, &input)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ulbwa/telegram-oidc-provider/api/generated"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
 	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
 )
 
@@ -16,16 +18,25 @@ import (
 func (s *server) PostBots(ctx context.Context, request generated.PostBotsRequestObject) (generated.PostBotsResponseObject, error) {
 	input := usecase.SyncBotInput{
 		BotToken: request.Body.Token,
+		ClientIP: clientip.FromContext(ctx),
+	}
+	if request.Body.InitDataVerification != nil {
+		input.InitDataVerification = utils.Ptr(entity.BotInitDataVerification(*request.Body.InitDataVerification))
 	}
 	output, err := s.syncBot.Execute(ctx, &input)
 	if err != nil {
-		code, resp, err := handleError(err)
-		if err != nil {
-			return nil, err
+		code, resp, handleErr := handleError(err)
+		if handleErr != nil {
+			return nil, handleErr
 		}
 		switch code {
 		case http.StatusBadRequest:
 			return generated.PostBots400JSONResponse(*resp), nil
+		case http.StatusTooManyRequests:
+			var httpResp generated.PostBots429JSONResponse
+			httpResp.Body = *resp
+			httpResp.Headers.RetryAfter = retryAfterSeconds(err)
+			return httpResp, nil
 		case http.StatusInternalServerError:
 			return generated.PostBots500JSONResponse(*resp), nil
 		default:
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// Create OIDC client for bot
+// (POST /bots/{id}/clients)
+func (s *server) PostBotsIdClients(ctx context.Context, request generated.PostBotsIdClientsRequestObject) (generated.PostBotsIdClientsResponseObject, error) {
+	input := usecase.CreateBotClientInput{
+		BotId:        request.Id,
+		ClientName:   request.Body.ClientName,
+		RedirectUris: request.Body.RedirectUris,
+	}
+	if request.Body.GrantTypes != nil {
+		for _, grantType := range *request.Body.GrantTypes {
+			input.GrantTypes = append(input.GrantTypes, string(grantType))
+		}
+	}
+	if request.Body.Scopes != nil {
+		input.Scopes = *request.Body.Scopes
+	}
+	if request.Body.TokenEndpointAuthMethod != nil {
+		input.TokenEndpointAuthMethod = utils.Ptr(string(*request.Body.TokenEndpointAuthMethod))
+	}
+
+	output, err := s.createBotClient.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PostBotsIdClients400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PostBotsIdClients404JSONResponse(*resp), nil
+		case http.StatusConflict:
+			return generated.PostBotsIdClients409JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PostBotsIdClients500JSONResponse(*resp), nil
+		case http.StatusBadGateway:
+			return generated.PostBotsIdClients502JSONResponse(*resp), nil
+		case http.StatusGatewayTimeout:
+			return generated.PostBotsIdClients504JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	var httpResp generated.PostBotsIdClients201JSONResponse
+	httpResp.Body.Id = output.BotId
+	httpResp.Body.ClientId = output.ClientId
+	httpResp.Body.ClientSecret = output.ClientSecret
+	httpResp.Body.ClientName = output.ClientName
+	httpResp.Body.RedirectUris = output.RedirectUris
+	httpResp.Body.GrantTypes = output.GrantTypes
+	httpResp.Body.Scopes = output.Scopes
+	httpResp.Body.TokenEndpointAuthMethod = output.TokenEndpointAuthMethod
+	httpResp.Headers.Location = s.baseUri.JoinPath("bots", strconv.FormatInt(output.BotId, 10), "client").String()
+	return httpResp, nil
+}
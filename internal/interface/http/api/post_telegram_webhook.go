@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/service"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+func mapTelegramUser(user *generated.TelegramUser) *service.TelegramUserData {
+	if user == nil {
+		return nil
+	}
+
+	return &service.TelegramUserData{
+		Id:           user.Id,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		Username:     user.Username,
+		LanguageCode: user.LanguageCode,
+		IsPremium:    user.IsPremium,
+	}
+}
+
+// Receive Telegram bot updates
+// (POST /telegram/webhook/{bot_id})
+func (s *server) PostTelegramWebhookBotId(ctx context.Context, request generated.PostTelegramWebhookBotIdRequestObject) (generated.PostTelegramWebhookBotIdResponseObject, error) {
+	input := usecase.ConfirmLoginByBotInput{
+		BotId:       request.BotId,
+		SecretToken: request.Params.XTelegramBotApiSecretToken,
+	}
+	if message := request.Body.Message; message != nil {
+		input.ChatId = message.Chat.Id
+		input.User = mapTelegramUser(message.From)
+		if message.Text != nil {
+			input.Text = *message.Text
+		}
+	}
+	if callbackQuery := request.Body.CallbackQuery; callbackQuery != nil && callbackQuery.Message != nil {
+		input.ChatId = callbackQuery.Message.Chat.Id
+		input.MessageId = callbackQuery.Message.MessageId
+		input.User = mapTelegramUser(&callbackQuery.From)
+		if callbackQuery.Data != nil {
+			input.CallbackData = *callbackQuery.Data
+		}
+	}
+
+	output, err := s.confirmLoginByBot.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PostTelegramWebhookBotId400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PostTelegramWebhookBotId404JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	var resp generated.PostTelegramWebhookBotId200JSONResponse
+	if output.ReplyText != nil {
+		resp.Method = utils.Ptr(generated.SendMessage)
+		resp.ChatId = utils.Ptr(output.ChatId)
+		resp.Text = output.ReplyText
+	}
+	// Editing the message also removes the confirm button, so it cannot be pressed twice
+	if output.MessageId != nil {
+		resp.Method = utils.Ptr(generated.EditMessageText)
+		resp.MessageId = output.MessageId
+	}
+	if button := output.ConfirmButton; button != nil {
+		resp.ReplyMarkup = &generated.TelegramInlineKeyboardMarkup{
+			InlineKeyboard: [][]generated.TelegramInlineKeyboardButton{{
+				{Text: button.Text, CallbackData: button.CallbackData},
+			}},
+		}
+	}
+	return resp, nil
+}
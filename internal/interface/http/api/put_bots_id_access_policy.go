@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Set access policy of the bot
+// (PUT /bots/{id}/access-policy)
+func (s *server) PutBotsIdAccessPolicy(ctx context.Context, request generated.PutBotsIdAccessPolicyRequestObject) (generated.PutBotsIdAccessPolicyResponseObject, error) {
+	input := usecase.SetBotAccessPolicyInput{
+		BotId: request.Id,
+	}
+	if request.Body.AllowedUserIds != nil {
+		input.AllowedUserIds = *request.Body.AllowedUserIds
+	}
+	if request.Body.DeniedUserIds != nil {
+		input.DeniedUserIds = *request.Body.DeniedUserIds
+	}
+	if request.Body.RequireUsername != nil {
+		input.RequireUsername = *request.Body.RequireUsername
+	}
+	if request.Body.RequirePremium != nil {
+		input.RequirePremium = *request.Body.RequirePremium
+	}
+	if request.Body.AllowedLanguages != nil {
+		input.AllowedLanguages = *request.Body.AllowedLanguages
+	}
+
+	output, err := s.setBotAccessPolicy.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PutBotsIdAccessPolicy400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PutBotsIdAccessPolicy404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PutBotsIdAccessPolicy500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.PutBotsIdAccessPolicy200JSONResponse(mapBotAccessPolicy(output.Policy)), nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Replace Telegram chats of the bot
+// (PUT /bots/{id}/chats)
+func (s *server) PutBotsIdChats(ctx context.Context, request generated.PutBotsIdChatsRequestObject) (generated.PutBotsIdChatsResponseObject, error) {
+	input := usecase.SetBotChatsInput{
+		BotId: request.Id,
+		Chats: make([]usecase.SetBotChatsItem, 0, len(request.Body.Items)),
+	}
+	for _, item := range request.Body.Items {
+		input.Chats = append(input.Chats, usecase.SetBotChatsItem{
+			ChatId: item.ChatId,
+			Group:  item.Group,
+		})
+	}
+
+	output, err := s.setBotChats.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PutBotsIdChats400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PutBotsIdChats404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PutBotsIdChats500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.PutBotsIdChats200JSONResponse(mapBotChats(output.Chats)), nil
+}
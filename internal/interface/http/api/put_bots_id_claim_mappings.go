@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// Replace scope to claim mappings of the bot
+// (PUT /bots/{id}/claim-mappings)
+func (s *server) PutBotsIdClaimMappings(ctx context.Context, request generated.PutBotsIdClaimMappingsRequestObject) (generated.PutBotsIdClaimMappingsResponseObject, error) {
+	input := usecase.SetBotClaimMappingsInput{
+		BotId:    request.Id,
+		Mappings: make([]usecase.SetBotClaimMappingsItem, 0, len(request.Body.Items)),
+	}
+	for _, item := range request.Body.Items {
+		input.Mappings = append(input.Mappings, usecase.SetBotClaimMappingsItem{
+			Scope:  item.Scope,
+			Claim:  item.Claim,
+			Source: string(item.Source),
+		})
+	}
+
+	output, err := s.setBotClaimMappings.Execute(ctx, &input)
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PutBotsIdClaimMappings400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PutBotsIdClaimMappings404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PutBotsIdClaimMappings500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.PutBotsIdClaimMappings200JSONResponse(mapBotClaimMappings(output.Mappings)), nil
+}
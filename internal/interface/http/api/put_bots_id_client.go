@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/pkg/utils"
+)
+
+// Link bot to OIDC client
+// (PUT /bots/{id}/client)
+func (s *server) PutBotsIdClient(ctx context.Context, request generated.PutBotsIdClientRequestObject) (generated.PutBotsIdClientResponseObject, error) {
+	output, err := s.linkBotToClient.Execute(ctx, &usecase.LinkBotToClientInput{
+		BotId:    request.Id,
+		ClientID: request.Body.ClientId,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PutBotsIdClient400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PutBotsIdClient404JSONResponse(*resp), nil
+		case http.StatusConflict:
+			return generated.PutBotsIdClient409JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PutBotsIdClient500JSONResponse(*resp), nil
+		case http.StatusBadGateway:
+			return generated.PutBotsIdClient502JSONResponse(*resp), nil
+		case http.StatusGatewayTimeout:
+			return generated.PutBotsIdClient504JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	var httpResp generated.PutBotsIdClient200JSONResponse
+	httpResp.Id = output.BotId
+	httpResp.ClientId = output.ClientID
+	httpResp.Updated = utils.Ptr(output.Updated)
+	return httpResp, nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/domain/entity"
+)
+
+// Set lifecycle status of the bot
+// (PUT /bots/{id}/status)
+func (s *server) PutBotsIdStatus(ctx context.Context, request generated.PutBotsIdStatusRequestObject) (generated.PutBotsIdStatusResponseObject, error) {
+	output, err := s.setBotStatus.Execute(ctx, &usecase.SetBotStatusInput{
+		BotId:  request.Id,
+		Status: entity.BotStatus(request.Body.Status),
+		Reason: request.Body.Reason,
+	})
+	if err != nil {
+		code, resp, err := handleError(err)
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case http.StatusBadRequest:
+			return generated.PutBotsIdStatus400JSONResponse(*resp), nil
+		case http.StatusNotFound:
+			return generated.PutBotsIdStatus404JSONResponse(*resp), nil
+		case http.StatusInternalServerError:
+			return generated.PutBotsIdStatus500JSONResponse(*resp), nil
+		default:
+			return nil, errors.New("unexpected error code from error handler")
+		}
+	}
+
+	return generated.PutBotsIdStatus200JSONResponse{
+		Id:        output.Bot.Id,
+		Status:    generated.BotStatus(output.Bot.Status),
+		Reason:    output.Bot.StatusReason,
+		ChangedAt: output.Bot.StatusChangedAt,
+		Updated:   output.Updated,
+	}, nil
+}
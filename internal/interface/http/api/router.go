@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/ulbwa/telegram-oidc-provider/api/generated"
+)
+
+// PrivateTag marks operations of the private (admin) API in api/openapi.yaml.
+const PrivateTag = "private"
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// taggedRouter registers only the routes of operations whose tags match the filter.
+type taggedRouter struct {
+	router generated.EchoRouter
+	routes map[string]struct{}
+}
+
+var _ generated.EchoRouter = (*taggedRouter)(nil)
+
+// NewTaggedRouter wraps the router so that generated.RegisterHandlers registers only operations
+// having the tag (include is true) or not having it (include is false).
+func NewTaggedRouter(router generated.EchoRouter, spec *openapi3.T, tag string, include bool) generated.EchoRouter {
+	routes := make(map[string]struct{})
+	for path, pathItem := range spec.Paths.Map() {
+		echoPath := pathParamPattern.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			if slices.Contains(operation.Tags, tag) == include {
+				routes[method+" "+echoPath] = struct{}{}
+			}
+		}
+	}
+	return &taggedRouter{router: router, routes: routes}
+}
+
+func (r *taggedRouter) allowed(method string, path string) bool {
+	_, ok := r.routes[method+" "+path]
+	return ok
+}
+
+func (r *taggedRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodConnect, path) {
+		return nil
+	}
+	return r.router.CONNECT(path, h, m...)
+}
+
+func (r *taggedRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodDelete, path) {
+		return nil
+	}
+	return r.router.DELETE(path, h, m...)
+}
+
+func (r *taggedRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodGet, path) {
+		return nil
+	}
+	return r.router.GET(path, h, m...)
+}
+
+func (r *taggedRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodHead, path) {
+		return nil
+	}
+	return r.router.HEAD(path, h, m...)
+}
+
+func (r *taggedRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodOptions, path) {
+		return nil
+	}
+	return r.router.OPTIONS(path, h, m...)
+}
+
+func (r *taggedRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodPatch, path) {
+		return nil
+	}
+	return r.router.PATCH(path, h, m...)
+}
+
+func (r *taggedRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodPost, path) {
+		return nil
+	}
+	return r.router.POST(path, h, m...)
+}
+
+func (r *taggedRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodPut, path) {
+		return nil
+	}
+	return r.router.PUT(path, h, m...)
+}
+
+func (r *taggedRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.allowed(http.MethodTrace, path) {
+		return nil
+	}
+	return r.router.TRACE(path, h, m...)
+}
@@ -9,9 +9,34 @@ import (
 )
 
 type server struct {
-	baseUri       *url.URL
-	syncBot       *usecase.SyncBot
-	loginByWidget *usecase.LoginByWidget
+	baseUri        *url.URL
+	syncBot        *usecase.SyncBot
+	loginByWidget  *usecase.LoginByWidget
+	loginByMiniApp *usecase.LoginByMiniApp
+	loginByBot     *usecase.LoginByBot
+
+	confirmLoginByBot   *usecase.ConfirmLoginByBot
+	getBotClaimMappings *usecase.GetBotClaimMappings
+	setBotClaimMappings *usecase.SetBotClaimMappings
+	linkBotToClient     *usecase.LinkBotToClient
+	unlinkBotFromClient *usecase.UnlinkBotFromClient
+	createBotClient     *usecase.CreateBotClient
+	getBot              *usecase.GetBot
+	listBots            *usecase.ListBots
+	deleteBot           *usecase.DeleteBot
+	listBotUsers        *usecase.ListBotUsers
+	getBotUser          *usecase.GetBotUser
+	deleteBotUser       *usecase.DeleteBotUser
+	exportUserData      *usecase.ExportUserData
+	eraseUserData       *usecase.EraseUserData
+	listLoginEvents     *usecase.ListLoginEvents
+
+	getBotAccessPolicy    *usecase.GetBotAccessPolicy
+	setBotAccessPolicy    *usecase.SetBotAccessPolicy
+	deleteBotAccessPolicy *usecase.DeleteBotAccessPolicy
+	getBotChats           *usecase.GetBotChats
+	setBotChats           *usecase.SetBotChats
+	setBotStatus          *usecase.SetBotStatus
 }
 
 var _ generated.StrictServerInterface = (*server)(nil)
@@ -20,6 +45,29 @@ func NewServer(
 	baseUri *url.URL,
 	syncBot *usecase.SyncBot,
 	loginByWidget *usecase.LoginByWidget,
+	loginByMiniApp *usecase.LoginByMiniApp,
+	loginByBot *usecase.LoginByBot,
+	confirmLoginByBot *usecase.ConfirmLoginByBot,
+	getBotClaimMappings *usecase.GetBotClaimMappings,
+	setBotClaimMappings *usecase.SetBotClaimMappings,
+	linkBotToClient *usecase.LinkBotToClient,
+	unlinkBotFromClient *usecase.UnlinkBotFromClient,
+	createBotClient *usecase.CreateBotClient,
+	getBot *usecase.GetBot,
+	listBots *usecase.ListBots,
+	deleteBot *usecase.DeleteBot,
+	listBotUsers *usecase.ListBotUsers,
+	getBotUser *usecase.GetBotUser,
+	deleteBotUser *usecase.DeleteBotUser,
+	exportUserData *usecase.ExportUserData,
+	eraseUserData *usecase.EraseUserData,
+	listLoginEvents *usecase.ListLoginEvents,
+	getBotAccessPolicy *usecase.GetBotAccessPolicy,
+	setBotAccessPolicy *usecase.SetBotAccessPolicy,
+	deleteBotAccessPolicy *usecase.DeleteBotAccessPolicy,
+	getBotChats *usecase.GetBotChats,
+	setBotChats *usecase.SetBotChats,
+	setBotStatus *usecase.SetBotStatus,
 ) (generated.StrictServerInterface, error) {
 	if baseUri == nil {
 		return nil, errors.New("baseUri cannot be nil")
@@ -33,10 +81,104 @@ func NewServer(
 	if loginByWidget == nil {
 		return nil, errors.New("loginByWidget cannot be nil")
 	}
+	if loginByMiniApp == nil {
+		return nil, errors.New("loginByMiniApp cannot be nil")
+	}
+	if loginByBot == nil {
+		return nil, errors.New("loginByBot cannot be nil")
+	}
+	if confirmLoginByBot == nil {
+		return nil, errors.New("confirmLoginByBot cannot be nil")
+	}
+	if getBotClaimMappings == nil {
+		return nil, errors.New("getBotClaimMappings cannot be nil")
+	}
+	if setBotClaimMappings == nil {
+		return nil, errors.New("setBotClaimMappings cannot be nil")
+	}
+	if linkBotToClient == nil {
+		return nil, errors.New("linkBotToClient cannot be nil")
+	}
+	if unlinkBotFromClient == nil {
+		return nil, errors.New("unlinkBotFromClient cannot be nil")
+	}
+	if createBotClient == nil {
+		return nil, errors.New("createBotClient cannot be nil")
+	}
+	if getBot == nil {
+		return nil, errors.New("getBot cannot be nil")
+	}
+	if listBots == nil {
+		return nil, errors.New("listBots cannot be nil")
+	}
+	if deleteBot == nil {
+		return nil, errors.New("deleteBot cannot be nil")
+	}
+	if listBotUsers == nil {
+		return nil, errors.New("listBotUsers cannot be nil")
+	}
+	if getBotUser == nil {
+		return nil, errors.New("getBotUser cannot be nil")
+	}
+	if deleteBotUser == nil {
+		return nil, errors.New("deleteBotUser cannot be nil")
+	}
+	if exportUserData == nil {
+		return nil, errors.New("exportUserData cannot be nil")
+	}
+	if eraseUserData == nil {
+		return nil, errors.New("eraseUserData cannot be nil")
+	}
+	if listLoginEvents == nil {
+		return nil, errors.New("listLoginEvents cannot be nil")
+	}
+	if getBotAccessPolicy == nil {
+		return nil, errors.New("getBotAccessPolicy cannot be nil")
+	}
+	if setBotAccessPolicy == nil {
+		return nil, errors.New("setBotAccessPolicy cannot be nil")
+	}
+	if deleteBotAccessPolicy == nil {
+		return nil, errors.New("deleteBotAccessPolicy cannot be nil")
+	}
+	if getBotChats == nil {
+		return nil, errors.New("getBotChats cannot be nil")
+	}
+	if setBotChats == nil {
+		return nil, errors.New("setBotChats cannot be nil")
+	}
+	if setBotStatus == nil {
+		return nil, errors.New("setBotStatus cannot be nil")
+	}
 
 	return &server{
-		baseUri:       baseUri,
-		syncBot:       syncBot,
-		loginByWidget: loginByWidget,
+		baseUri:        baseUri,
+		syncBot:        syncBot,
+		loginByWidget:  loginByWidget,
+		loginByMiniApp: loginByMiniApp,
+		loginByBot:     loginByBot,
+
+		confirmLoginByBot:   confirmLoginByBot,
+		getBotClaimMappings: getBotClaimMappings,
+		setBotClaimMappings: setBotClaimMappings,
+		linkBotToClient:     linkBotToClient,
+		unlinkBotFromClient: unlinkBotFromClient,
+		createBotClient:     createBotClient,
+		getBot:              getBot,
+		listBots:            listBots,
+		deleteBot:           deleteBot,
+		listBotUsers:        listBotUsers,
+		getBotUser:          getBotUser,
+		deleteBotUser:       deleteBotUser,
+		exportUserData:      exportUserData,
+		eraseUserData:       eraseUserData,
+		listLoginEvents:     listLoginEvents,
+
+		getBotAccessPolicy:    getBotAccessPolicy,
+		setBotAccessPolicy:    setBotAccessPolicy,
+		deleteBotAccessPolicy: deleteBotAccessPolicy,
+		getBotChats:           getBotChats,
+		setBotChats:           setBotChats,
+		setBotStatus:          setBotStatus,
 	}, nil
 }
@@ -0,0 +1,143 @@
+// Package clientip resolves the address of the client behind trusted reverse proxies.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Header is a request header a trusted proxy reports the client address in.
+type Header string
+
+const (
+	HeaderXForwardedFor Header = "x-forwarded-for"
+	HeaderXRealIP       Header = "x-real-ip"
+	HeaderForwarded     Header = "forwarded" // RFC 7239
+)
+
+type contextKey struct{}
+
+// NewExtractor creates an echo IP extractor that trusts the header only when the request
+// comes from one of the trusted proxies. Without trusted proxies the peer address is used.
+func NewExtractor(trustedProxies []netip.Prefix, header Header) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, prefix := range trustedProxies {
+		options = append(options, echo.TrustIPRange(&net.IPNet{
+			IP:   prefix.Masked().Addr().AsSlice(),
+			Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+		}))
+	}
+
+	switch header {
+	case HeaderXForwardedFor:
+		return echo.ExtractIPFromXFFHeader(options...), nil
+	case HeaderXRealIP:
+		return echo.ExtractIPFromRealIPHeader(options...), nil
+	case HeaderForwarded:
+		return extractIPFromForwardedHeader(trustedProxies), nil
+	default:
+		return nil, fmt.Errorf("unsupported client IP header %q", header)
+	}
+}
+
+// extractIPFromForwardedHeader returns the nearest untrusted "for" address of the Forwarded
+// header, mirroring the X-Forwarded-For strategy of echo.
+func extractIPFromForwardedHeader(trustedProxies []netip.Prefix) echo.IPExtractor {
+	trusted := func(addr netip.Addr) bool {
+		for _, prefix := range trustedProxies {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(req *http.Request) string {
+		directIP := echo.ExtractIPDirect()(req)
+		direct, err := netip.ParseAddr(directIP)
+		if err != nil || !trusted(direct.Unmap()) {
+			return directIP
+		}
+
+		values := req.Header.Values("Forwarded")
+		if len(values) == 0 {
+			return directIP
+		}
+		elements := strings.Split(strings.Join(values, ","), ",")
+
+		var furthest netip.Addr
+		for i := len(elements) - 1; i >= 0; i-- {
+			addr, ok := parseForwardedFor(elements[i])
+			if !ok {
+				// Unknown or obfuscated identifiers cannot be trusted any further
+				return directIP
+			}
+			if !trusted(addr) {
+				return addr.String()
+			}
+			furthest = addr
+		}
+		return furthest.String()
+	}
+}
+
+// parseForwardedFor extracts the address of the "for" parameter of a Forwarded header element.
+func parseForwardedFor(element string) (netip.Addr, bool) {
+	for _, pair := range strings.Split(element, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || !strings.EqualFold(key, "for") {
+			continue
+		}
+
+		value = strings.Trim(value, `"`)
+		if strings.HasPrefix(value, "[") {
+			end := strings.Index(value, "]")
+			if end < 0 {
+				return netip.Addr{}, false
+			}
+			value = value[1:end]
+		} else if host, _, err := net.SplitHostPort(value); err == nil {
+			value = host
+		}
+
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		return addr.Unmap(), true
+	}
+	return netip.Addr{}, false
+}
+
+// Middleware stores the client address resolved by the echo IP extractor in the request context.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if addr, err := netip.ParseAddr(c.RealIP()); err == nil {
+				req := c.Request()
+				c.SetRequest(req.WithContext(context.WithValue(req.Context(), contextKey{}, addr.Unmap())))
+			}
+			return next(c)
+		}
+	}
+}
+
+// FromContext returns the client address stored by Middleware, or the zero address when unknown.
+func FromContext(ctx context.Context) netip.Addr {
+	addr, _ := ctx.Value(contextKey{}).(netip.Addr)
+	return addr
+}
@@ -0,0 +1,38 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// botPhotoMaxAge is the cache lifetime of bot photos. Photo URIs change along with the photo.
+const botPhotoMaxAge = 24 * 60 * 60
+
+// BotPhoto serves the profile photo of a bot shown on the login page and in the admin API.
+func (s *server) BotPhoto(c echo.Context) error {
+	botId, err := strconv.ParseInt(c.Param("bot_id"), 10, 64)
+	if err != nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	output, err := s.getBotPhotoUsecase.Execute(c.Request().Context(), &usecase.GetBotPhotoInput{BotId: botId})
+	if err != nil {
+		var objectNotFoundErr *usecase.ObjectNotFoundErr
+		if errors.As(err, &objectNotFoundErr) {
+			return c.NoContent(http.StatusNotFound)
+		}
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	etag := strconv.Quote(output.Photo.FileUniqueId)
+	c.Response().Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(botPhotoMaxAge))
+	c.Response().Header().Set("ETag", etag)
+	if c.Request().Header.Get("If-None-Match") == etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.Blob(http.StatusOK, output.Photo.ContentType, output.Photo.Content)
+}
@@ -0,0 +1,104 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+// consentScopeDescriptions holds human-readable descriptions of well-known scopes.
+var consentScopeDescriptions = map[string]string{
+	"openid":         "Your Telegram ID",
+	"profile":        "Your name, username, photo and language",
+	"groups":         "Your membership in the Telegram chats of the app",
+	"offline_access": "Stay signed in when you are not using the app",
+}
+
+type consentScope struct {
+	Name        string
+	Description string
+}
+
+func buildConsentScopes(scopes []string) []consentScope {
+	result := make([]consentScope, 0, len(scopes))
+	for _, scope := range scopes {
+		description, ok := consentScopeDescriptions[scope]
+		if !ok {
+			description = scope
+		}
+		result = append(result, consentScope{Name: scope, Description: description})
+	}
+	return result
+}
+
+func (s *server) Consent(c echo.Context) error {
+	input := usecase.ResolveConsentChallengeInput{
+		ConsentChallenge: c.QueryParam("consent_challenge"),
+	}
+	output, err := s.resolveConsentChallengeUsecase.Execute(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+		}
+
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+
+	switch output.Action {
+	case usecase.ResolveConsentChallengeActionRedirect:
+		return c.Redirect(http.StatusFound, *output.RedirectUri)
+	case usecase.ResolveConsentChallengeActionRender:
+		data := map[string]any{
+			"ConsentChallenge": input.ConsentChallenge,
+			"ClientName":       *output.ClientName,
+			"BotUsername":      *output.BotUsername,
+			"UserDisplayName":  *output.UserDisplayName,
+			"UserPhotoUrl":     "",
+			"Scopes":           buildConsentScopes(output.RequestedScopes),
+		}
+		if output.UserPhotoUrl != nil {
+			data["UserPhotoUrl"] = *output.UserPhotoUrl
+		}
+		return c.Render(http.StatusOK, "consent", data)
+	default:
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+}
+
+func (s *server) SubmitConsent(c echo.Context) error {
+	consentChallenge := c.FormValue("consent_challenge")
+
+	var (
+		redirectUri string
+		err         error
+	)
+	if c.FormValue("action") == "accept" {
+		input := usecase.AcceptConsentInput{
+			ConsentChallenge: consentChallenge,
+			Remember:         c.FormValue("remember") == "true",
+		}
+		var output *usecase.AcceptConsentOutput
+		if output, err = s.acceptConsentUsecase.Execute(c.Request().Context(), &input); err == nil {
+			redirectUri = output.RedirectUri
+		}
+	} else {
+		input := usecase.RejectConsentInput{
+			ConsentChallenge: consentChallenge,
+		}
+		var output *usecase.RejectConsentOutput
+		if output, err = s.rejectConsentUsecase.Execute(c.Request().Context(), &input); err == nil {
+			redirectUri = output.RedirectUri
+		}
+	}
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+		}
+
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+
+	return c.Redirect(http.StatusFound, redirectUri)
+}
@@ -13,6 +13,8 @@ var (
 	ErrCodeInvalidRequest        ErrorCode = "invalid_request"
 	ErrCodeInvalidClient         ErrorCode = "invalid_client"
 	ErrCodeInvalidBotCredentials ErrorCode = "invalid_bot_credentials"
+	ErrCodeAccessDenied          ErrorCode = "access_denied"
+	ErrCodeBotUnavailable        ErrorCode = "bot_unavailable"
 )
 
 func (s *server) fallbackToErrorPage(c echo.Context, errCode ErrorCode) error {
@@ -2,15 +2,31 @@ package web
 
 import (
 	"errors"
+	"html/template"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
 	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
 )
 
 func (s *server) Login(c echo.Context) error {
+	var userAgent *string
+	if ua := c.Request().UserAgent(); ua != "" {
+		userAgent = &ua
+	}
+
 	input := usecase.ResolveLoginChallengeInput{
 		LoginChallenge: c.QueryParam("login_challenge"),
+		UserAgent:      userAgent,
+		ClientIP:       clientip.FromContext(c.Request().Context()),
+	}
+	if cookie, err := c.Cookie(loginHintBindingCookie); err == nil && cookie.Value != "" {
+		input.LoginHintBinding = &cookie.Value
+		// The binding is single-use like the login hint itself
+		setLoginHintBindingCookie(c, "", -time.Second)
 	}
 	output, err := s.resolveLoginChallengeUsecase.Execute(c.Request().Context(), &input)
 	if err != nil {
@@ -25,10 +41,37 @@ func (s *server) Login(c echo.Context) error {
 	case usecase.ResolveLoginChallengeActionRedirect:
 		return c.Redirect(http.StatusFound, *output.RedirectUri)
 	case usecase.ResolveLoginChallengeActionRender:
-		return c.Render(http.StatusOK, "login", map[string]any{
-			"WidgetUri":          *output.WidgetUri,
-			"MiniAppCallbackUri": *output.MiniAppCallbackUri,
-		})
+		data := map[string]any{
+			"WidgetUri":           *output.WidgetUri,
+			"MiniAppCallbackUri":  *output.MiniAppCallbackUri,
+			"BotLoginUri":         "",
+			"BotCallbackUri":      "",
+			"BotLoginQrCodeUri":   template.URL(""),
+			"BotName":             "",
+			"BotShortDescription": "",
+			"BotPhotoUri":         "",
+		}
+		if output.BotLoginUri != nil && output.BotCallbackUri != nil {
+			data["BotLoginUri"] = *output.BotLoginUri
+			data["BotCallbackUri"] = *output.BotCallbackUri
+
+			qrCodeUri, err := buildQrCodeUri(*output.BotLoginUri)
+			if err != nil {
+				zerolog.Ctx(c.Request().Context()).Warn().Err(err).Msg("failed to render bot login qr code")
+			} else {
+				data["BotLoginQrCodeUri"] = qrCodeUri
+			}
+		}
+		if output.BotName != nil {
+			data["BotName"] = *output.BotName
+		}
+		if output.BotShortDescription != nil {
+			data["BotShortDescription"] = *output.BotShortDescription
+		}
+		if output.BotPhotoUri != nil {
+			data["BotPhotoUri"] = *output.BotPhotoUri
+		}
+		return c.Render(http.StatusOK, "login", data)
 	default:
 		return s.fallbackToErrorPage(c, ErrCodeInternalError)
 	}
@@ -0,0 +1,99 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+	"github.com/ulbwa/telegram-oidc-provider/internal/interface/http/clientip"
+)
+
+// loginHintBindingCookie holds the secret that binds a login hint to the browser it was issued to.
+// Set by the login URL handler and presented to the login page once the relying party starts the flow.
+const loginHintBindingCookie = "login_hint_binding"
+
+func setLoginHintBindingCookie(c echo.Context, value string, ttl time.Duration) {
+	c.SetCookie(&http.Cookie{
+		Name:     loginHintBindingCookie,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   int(ttl.Seconds()),
+		Secure:   c.Scheme() == "https",
+		HttpOnly: true,
+		// Lax cookies are still sent on the top-level redirects from the relying party through Hydra
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func mapLoginUrlErrorCode(err error) ErrorCode {
+	var botUnavailableErr *usecase.BotUnavailableErr
+	if errors.As(err, &botUnavailableErr) {
+		if botUnavailableErr.Reason == usecase.BotUnavailableReasonCredentialsInvalid {
+			return ErrCodeInvalidBotCredentials
+		}
+		return ErrCodeBotUnavailable
+	}
+
+	var accessDeniedErr *usecase.AccessDeniedErr
+	if errors.As(err, &accessDeniedErr) {
+		return ErrCodeAccessDenied
+	}
+
+	var objectInvalidErr *usecase.ObjectInvalidErr
+	if errors.As(err, &objectInvalidErr) {
+		if objectInvalidErr.Object == "bot" && objectInvalidErr.Field == "token" {
+			return ErrCodeInvalidBotCredentials
+		}
+		if objectInvalidErr.Object == "client" {
+			return ErrCodeInvalidClient
+		}
+	}
+
+	var objectNotFoundErr *usecase.ObjectNotFoundErr
+	if errors.As(err, &objectNotFoundErr) && objectNotFoundErr.Object == "client" {
+		return ErrCodeInvalidClient
+	}
+
+	if errors.Is(err, usecase.ErrInvalidInput) {
+		return ErrCodeInvalidRequest
+	}
+
+	return ErrCodeInternalError
+}
+
+// LoginUrl handles Telegram login_url inline button authorization.
+// Telegram appends widget-style signed user data to the query string.
+func (s *server) LoginUrl(c echo.Context) error {
+	botId, err := strconv.ParseInt(c.Param("bot_id"), 10, 64)
+	if err != nil {
+		return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+	}
+
+	queryParams := c.QueryParams()
+	authData := make(map[string]any, len(queryParams))
+	for key := range queryParams {
+		authData[key] = queryParams.Get(key)
+	}
+
+	var userAgent *string
+	if ua := c.Request().UserAgent(); ua != "" {
+		userAgent = &ua
+	}
+
+	input := usecase.LoginByLoginUrlInput{
+		BotId:     botId,
+		AuthData:  authData,
+		UserAgent: userAgent,
+		ClientIP:  clientip.FromContext(c.Request().Context()),
+	}
+	output, err := s.loginByLoginUrlUsecase.Execute(c.Request().Context(), &input)
+	if err != nil {
+		return s.fallbackToErrorPage(c, mapLoginUrlErrorCode(err))
+	}
+
+	setLoginHintBindingCookie(c, output.LoginHintBinding, output.LoginHintTTL)
+	return c.Redirect(http.StatusFound, output.RedirectUri)
+}
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ulbwa/telegram-oidc-provider/internal/application/usecase"
+)
+
+func (s *server) Logout(c echo.Context) error {
+	input := usecase.ResolveLogoutChallengeInput{
+		LogoutChallenge: c.QueryParam("logout_challenge"),
+	}
+	output, err := s.resolveLogoutChallengeUsecase.Execute(c.Request().Context(), &input)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+		}
+
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+
+	switch output.Action {
+	case usecase.ResolveLogoutChallengeActionRedirect:
+		return c.Redirect(http.StatusFound, *output.RedirectUri)
+	case usecase.ResolveLogoutChallengeActionRender:
+		data := map[string]any{
+			"LogoutChallenge": input.LogoutChallenge,
+			"ClientName":      "",
+			"Cancelled":       false,
+		}
+		if output.ClientName != nil {
+			data["ClientName"] = *output.ClientName
+		}
+		return c.Render(http.StatusOK, "logout", data)
+	default:
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+}
+
+func (s *server) SubmitLogout(c echo.Context) error {
+	logoutChallenge := c.FormValue("logout_challenge")
+
+	if c.FormValue("action") == "accept" {
+		input := usecase.AcceptLogoutInput{
+			LogoutChallenge: logoutChallenge,
+		}
+		output, err := s.acceptLogoutUsecase.Execute(c.Request().Context(), &input)
+		if err != nil {
+			if errors.Is(err, usecase.ErrInvalidInput) {
+				return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+			}
+
+			return s.fallbackToErrorPage(c, ErrCodeInternalError)
+		}
+
+		return c.Redirect(http.StatusFound, output.RedirectUri)
+	}
+
+	input := usecase.RejectLogoutInput{
+		LogoutChallenge: logoutChallenge,
+	}
+	if _, err := s.rejectLogoutUsecase.Execute(c.Request().Context(), &input); err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			return s.fallbackToErrorPage(c, ErrCodeInvalidRequest)
+		}
+
+		return s.fallbackToErrorPage(c, ErrCodeInternalError)
+	}
+
+	return c.Render(http.StatusOK, "logout", map[string]any{
+		"LogoutChallenge": "",
+		"ClientName":      "",
+		"Cancelled":       true,
+	})
+}
@@ -0,0 +1,22 @@
+package web
+
+import (
+	"encoding/base64"
+	"html/template"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// qrCodeSize is the width and height of rendered QR codes in pixels.
+const qrCodeSize = 192
+
+// buildQrCodeUri renders the content as a PNG QR code embedded into a data URI, so that
+// the login page does not depend on third-party scripts.
+func buildQrCodeUri(content string) (template.URL, error) {
+	png, err := qrcode.Encode(content, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return "", err
+	}
+	// The URI is built from the encoded image only, it is safe to render as is
+	return template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(png)), nil
+}
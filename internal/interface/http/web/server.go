@@ -14,6 +14,17 @@ type server struct {
 	errorUri *url.URL
 
 	resolveLoginChallengeUsecase *usecase.ResolveLoginChallenge
+	loginByLoginUrlUsecase       *usecase.LoginByLoginUrl
+
+	resolveConsentChallengeUsecase *usecase.ResolveConsentChallenge
+	acceptConsentUsecase           *usecase.AcceptConsent
+	rejectConsentUsecase           *usecase.RejectConsent
+
+	resolveLogoutChallengeUsecase *usecase.ResolveLogoutChallenge
+	acceptLogoutUsecase           *usecase.AcceptLogout
+	rejectLogoutUsecase           *usecase.RejectLogout
+
+	getBotPhotoUsecase *usecase.GetBotPhoto
 }
 
 type renderer struct {
@@ -27,10 +38,29 @@ func (r *renderer) Render(w io.Writer, name string, data interface{}, _ echo.Con
 func NewServer(
 	errorUri *url.URL,
 	resolveLoginChallengeUsecase *usecase.ResolveLoginChallenge,
+	loginByLoginUrlUsecase *usecase.LoginByLoginUrl,
+	resolveConsentChallengeUsecase *usecase.ResolveConsentChallenge,
+	acceptConsentUsecase *usecase.AcceptConsent,
+	rejectConsentUsecase *usecase.RejectConsent,
+	resolveLogoutChallengeUsecase *usecase.ResolveLogoutChallenge,
+	acceptLogoutUsecase *usecase.AcceptLogout,
+	rejectLogoutUsecase *usecase.RejectLogout,
+	getBotPhotoUsecase *usecase.GetBotPhoto,
 ) *server {
 	return &server{
 		errorUri:                     errorUri,
 		resolveLoginChallengeUsecase: resolveLoginChallengeUsecase,
+		loginByLoginUrlUsecase:       loginByLoginUrlUsecase,
+
+		resolveConsentChallengeUsecase: resolveConsentChallengeUsecase,
+		acceptConsentUsecase:           acceptConsentUsecase,
+		rejectConsentUsecase:           rejectConsentUsecase,
+
+		resolveLogoutChallengeUsecase: resolveLogoutChallengeUsecase,
+		acceptLogoutUsecase:           acceptLogoutUsecase,
+		rejectLogoutUsecase:           rejectLogoutUsecase,
+
+		getBotPhotoUsecase: getBotPhotoUsecase,
 	}
 }
 
@@ -49,10 +79,20 @@ func NewRenderer() (echo.Renderer, error) {
 		return nil, err
 	}
 
+	if _, err = tmpl.New("logout").Parse(templates.LogoutTemplate()); err != nil {
+		return nil, err
+	}
+
 	return &renderer{tmpl: tmpl}, nil
 }
 
 func (s *server) Register(e *echo.Echo) {
 	e.GET("/login", s.Login)
+	e.GET("/login-url/:bot_id", s.LoginUrl)
+	e.GET("/consent", s.Consent)
+	e.POST("/consent", s.SubmitConsent)
+	e.GET("/logout", s.Logout)
+	e.POST("/logout", s.SubmitLogout)
 	e.GET("/error", s.Error)
+	e.GET("/bot-photos/:bot_id", s.BotPhoto)
 }
@@ -11,6 +11,9 @@ var errorTemplate string
 //go:embed consent.html
 var consentTemplate string
 
+//go:embed logout.html
+var logoutTemplate string
+
 func LoginTemplate() string {
 	return loginTemplate
 }
@@ -22,3 +25,7 @@ func ErrorTemplate() string {
 func ConsentTemplate() string {
 	return consentTemplate
 }
+
+func LogoutTemplate() string {
+	return logoutTemplate
+}